@@ -0,0 +1,57 @@
+package main
+
+import (
+	"strings"
+)
+
+// the audit columns found without configuration, UPDATED_AT_COLUMN_NAME is
+// shared with the optimistic locking
+const CREATED_AT_COLUMN_NAME = "created_at"
+
+// isAuditColumn tells whether column can hold an audit timestamp set to now()
+func isAuditColumn(column *Column) bool {
+	return !column.IsPrimary && column.GoType == "" && strings.HasPrefix(column.Type, "timestamp")
+}
+
+// createdAtColumn returns the timestamp the insert sets : the configured one,
+// else created_at, nil when none
+func (table *Table) createdAtColumn() *Column {
+	return table.auditColumn(table.CreatedAtColumn, CREATED_AT_COLUMN_NAME)
+}
+
+// updatedAtColumn returns the timestamp the insert and the updates set : the
+// configured one, else updated_at, nil when none
+func (table *Table) updatedAtColumn() *Column {
+	return table.auditColumn(table.UpdatedAtColumn, UPDATED_AT_COLUMN_NAME)
+}
+
+func (table *Table) auditColumn(configured string, name string) *Column {
+	if configured != "" {
+		return table.goColumn(configured)
+	}
+	column := table.goColumn(name)
+	if column != nil && isAuditColumn(column) {
+		return column
+	}
+	return nil
+}
+
+// isAuditTimestamp tells whether the inserts set column to now(), a read only
+// column keeps its default
+func (table *Table) isAuditTimestamp(column *Column) bool {
+	return !column.ReadOnly && (column == table.createdAtColumn() || column == table.updatedAtColumn())
+}
+
+// isCreateParam tells whether create<Entity> takes the value of column : not
+// the primary key, read only, audit or version columns the insert sets
+func (table *Table) isCreateParam(column *Column) bool {
+	return !column.IsPrimary && !column.ReadOnly && !table.isAuditTimestamp(column) && column != table.versionColumn()
+}
+
+// auditFakeValue returns the value the fake sets to an audit column
+func auditFakeValue(column *Column) string {
+	if isNullWrapped(column) {
+		return "sql.NullTime{Time: time.Now(), Valid: true}"
+	}
+	return "time.Now()"
+}
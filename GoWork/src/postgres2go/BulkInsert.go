@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+	"github.com/lib/pq"
+	"io"
+	"strings"
+)
+
+// a statement takes at most 65535 parameters, the batches of the multi-row
+// insert stay under it
+const POSTGRES_MAX_PARAMETERS = 65535
+
+// insertColumns returns the columns the inserts set from the entities,
+// without the primary key, the read only columns left to their default and
+// the audit timestamps
+func insertColumns(table *Table, names *TableNames, columns []*Column) ([]*Column, []string) {
+	result := make([]*Column, 0, len(columns))
+	fields := make([]string, 0, len(columns))
+	for i, column := range columns {
+		if table.isCreateParam(column) {
+			result = append(result, column)
+			fields = append(fields, names.Fields[i])
+		}
+	}
+	return result, fields
+}
+
+// bulkInsertsUseTime tells whether the bulk inserts of table call time.Now()
+// for its audit timestamps or its timestamp version
+func bulkInsertsUseTime(table *Table, names *TableNames, columns []*Column) bool {
+	inserted, _ := insertColumns(table, names, columns)
+	for _, column := range columns {
+		if len(inserted) > 0 && (table.isAuditTimestamp(column) || table.initialVersion(column) == "now()") {
+			return true
+		}
+	}
+	return false
+}
+
+// writeBulkInserts writes bulkInsert<Entity>, a COPY in a transaction, and
+// bulkInsert<Entity>Returning, batches of multi-row INSERT ... RETURNING for
+// the callers needing the generated keys
+func writeBulkInserts(writer io.Writer, layout *OutputLayout, table *Table, names *TableNames, columns []*Column) {
+	inserted, fields := insertColumns(table, names, columns)
+	if len(inserted) == 0 {
+		return
+	}
+	entityType := layout.qualifier(KIND_DAO, KIND_MODEL) + names.Entity
+	mapError := mapErrorFuncName(names)
+	columnNames := make([]string, 0, len(columns))
+	values := make([]string, 0, len(columns))
+	for i, column := range inserted {
+		columnNames = append(columnNames, column.Name)
+		values = append(values, "entity."+fields[i])
+	}
+	// one time for the audit timestamps of every row, like the now() of create
+	withNow := false
+	for _, column := range columns {
+		if table.isAuditTimestamp(column) || table.initialVersion(column) == "now()" {
+			columnNames = append(columnNames, column.Name)
+			values = append(values, "now")
+			withNow = true
+		} else if table.initialVersion(column) != "" {
+			columnNames = append(columnNames, column.Name)
+			values = append(values, table.initialVersion(column))
+		}
+	}
+	// pq.CopyIn quotes the names itself, the multi-row insert quotes them here
+	copyColumns := make([]string, 0, len(columnNames))
+	insertColumns := make([]string, 0, len(columnNames))
+	for _, columnName := range columnNames {
+		insertColumns = append(insertColumns, pq.QuoteIdentifier(columnName))
+		copyColumns = append(copyColumns, fmt.Sprintf("%q", columnName))
+	}
+	fmt.Fprintf(writer, "// %s copies entities in one transaction, the keys and defaults\n", layout.funcName("bulkInsert"+names.Entity))
+	fmt.Fprintf(writer, "// postgres generates are not read back\n")
+	fmt.Fprintf(writer, "func %s(ctx context.Context, db *sql.DB, entities []*%s) error {\n", layout.funcName("bulkInsert"+names.Entity), entityType)
+	if withNow {
+		fmt.Fprintf(writer, "\tnow := time.Now()\n")
+	}
+	fmt.Fprintf(writer, "\treturn %s(ctx, db, func(tx *sql.Tx) error {\n", layout.funcName("withTx"))
+	fmt.Fprintf(writer, "\t\tstmt, err := tx.PrepareContext(ctx, pq.CopyIn(%q, %s))\n", table.Name, strings.Join(copyColumns, ", "))
+	fmt.Fprintf(writer, "\t\tif err != nil {\n")
+	fmt.Fprintf(writer, "\t\t\treturn err\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t\tdefer stmt.Close()\n")
+	fmt.Fprintf(writer, "\t\tfor _, entity := range entities {\n")
+	fmt.Fprintf(writer, "\t\t\t_, err = stmt.ExecContext(ctx, %s)\n", strings.Join(values, ", "))
+	fmt.Fprintf(writer, "\t\t\tif err != nil {\n")
+	fmt.Fprintf(writer, "\t\t\t\treturn %s(err)\n", mapError)
+	fmt.Fprintf(writer, "\t\t\t}\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t\t// the exec without arguments flushes the copy\n")
+	fmt.Fprintf(writer, "\t\t_, err = stmt.ExecContext(ctx)\n")
+	fmt.Fprintf(writer, "\t\tif err != nil {\n")
+	fmt.Fprintf(writer, "\t\t\treturn %s(err)\n", mapError)
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t\treturn stmt.Close()\n")
+	fmt.Fprintf(writer, "\t})\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	batchSize := POSTGRES_MAX_PARAMETERS / len(values)
+	fmt.Fprintf(writer, "// %s inserts entities by batches of %d rows and returns\n", layout.funcName("bulkInsert"+names.Entity+"Returning"), batchSize)
+	fmt.Fprintf(writer, "// the rows as inserted, postgres does not promise the order of RETURNING so\n")
+	fmt.Fprintf(writer, "// match them to entities by their columns, not by their index\n")
+	fmt.Fprintf(writer, "func %s(ctx context.Context, q DBTX, entities []*%s) ([]*%s, error) {\n", layout.funcName("bulkInsert"+names.Entity+"Returning"), entityType, entityType)
+	if withNow {
+		fmt.Fprintf(writer, "\tnow := time.Now()\n")
+	}
+	fmt.Fprintf(writer, "\tresult := make([]*%s, 0, len(entities))\n", entityType)
+	fmt.Fprintf(writer, "\tfor start := 0; start < len(entities); start += %d {\n", batchSize)
+	fmt.Fprintf(writer, "\t\tend := start + %d\n", batchSize)
+	fmt.Fprintf(writer, "\t\tif end > len(entities) {\n")
+	fmt.Fprintf(writer, "\t\t\tend = len(entities)\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t\targs := make([]interface{}, 0, (end-start)*%d)\n", len(values))
+	fmt.Fprintf(writer, "\t\tfor _, entity := range entities[start:end] {\n")
+	fmt.Fprintf(writer, "\t\t\targs = append(args, %s)\n", strings.Join(values, ", "))
+	fmt.Fprintf(writer, "\t\t}\n")
+	insert := fmt.Sprintf("insert into %s(%s) values ", pq.QuoteIdentifier(table.Name), strings.Join(insertColumns, ","))
+	fmt.Fprintf(writer, "\t\trows, err := q.QueryContext(ctx, %q+valuesPlaceholders(end-start, %d)+%q, args...)\n", insert, len(values), " returning "+sqlColumnList(columns))
+	fmt.Fprintf(writer, "\t\tif err != nil {\n")
+	fmt.Fprintf(writer, "\t\t\treturn nil, %s(err)\n", mapError)
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t\tfor rows.Next() {\n")
+	fmt.Fprintf(writer, "\t\t\tentity, err := rowsNoFetchResultSetTo%s(rows)\n", names.Entity)
+	fmt.Fprintf(writer, "\t\t\tif err != nil {\n")
+	fmt.Fprintf(writer, "\t\t\t\trows.Close()\n")
+	fmt.Fprintf(writer, "\t\t\t\treturn nil, err\n")
+	fmt.Fprintf(writer, "\t\t\t}\n")
+	fmt.Fprintf(writer, "\t\t\tresult = append(result, entity)\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t\terr = rows.Err()\n")
+	fmt.Fprintf(writer, "\t\trows.Close()\n")
+	fmt.Fprintf(writer, "\t\tif err != nil {\n")
+	fmt.Fprintf(writer, "\t\t\treturn nil, %s(err)\n", mapError)
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn result, nil\n")
+	fmt.Fprintf(writer, "}\n\n")
+}
+
+// writeValuesPlaceholders writes the helper numbering the parameters of a
+// multi-row insert, ex ($1,$2),($3,$4)
+func writeValuesPlaceholders(writer io.Writer) {
+	fmt.Fprintf(writer, "func valuesPlaceholders(rowCount int, columnCount int) string {\n")
+	fmt.Fprintf(writer, "\tvar builder strings.Builder\n")
+	fmt.Fprintf(writer, "\tfor row := 0; row < rowCount; row++ {\n")
+	fmt.Fprintf(writer, "\t\tif row > 0 {\n")
+	fmt.Fprintf(writer, "\t\t\tbuilder.WriteString(\",\")\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t\tbuilder.WriteString(\"(\")\n")
+	fmt.Fprintf(writer, "\t\tfor column := 1; column <= columnCount; column++ {\n")
+	fmt.Fprintf(writer, "\t\t\tif column > 1 {\n")
+	fmt.Fprintf(writer, "\t\t\t\tbuilder.WriteString(\",\")\n")
+	fmt.Fprintf(writer, "\t\t\t}\n")
+	fmt.Fprintf(writer, "\t\t\tbuilder.WriteString(\"$\" + strconv.Itoa(row*columnCount+column))\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t\tbuilder.WriteString(\")\")\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn builder.String()\n")
+	fmt.Fprintf(writer, "}\n\n")
+}
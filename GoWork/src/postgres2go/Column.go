@@ -6,6 +6,15 @@ type Column struct {
 	IsNullable bool
 	IsPrimary  bool
 	IsForeign  bool
+	IsEnum     bool
+	Comment    string
+	// overrides of the config tables section
+	GoName       string
+	JsonName     string
+	GoType       string
+	Omit         bool
+	OmitFromJson bool
+	ReadOnly     bool
 }
 
 func NewColumn(name_ string, type_ string, isnullable_ bool) *Column {
@@ -0,0 +1,451 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	CONFIG_FORMAT_JSON = "json"
+	CONFIG_FORMAT_YAML = "yaml"
+	CONFIG_FORMAT_TOML = "toml"
+)
+
+const (
+	CONFIG_NULL = iota
+	CONFIG_SCALAR
+	CONFIG_MAP
+	CONFIG_LIST
+)
+
+// ConfigNode is a parsed config document, whatever its format, with the line
+// of every value so decoding errors can point at the file
+type ConfigNode struct {
+	Kind   int
+	Line   int
+	Value  string
+	Quoted bool
+	Keys   []string
+	Fields map[string]*ConfigNode
+	Items  []*ConfigNode
+}
+
+func NewConfigMap(line_ int) *ConfigNode {
+	return &ConfigNode{Kind: CONFIG_MAP, Line: line_, Keys: make([]string, 0, 0), Fields: make(map[string]*ConfigNode)}
+}
+
+func NewConfigList(line_ int) *ConfigNode {
+	return &ConfigNode{Kind: CONFIG_LIST, Line: line_, Items: make([]*ConfigNode, 0, 0)}
+}
+
+func NewConfigScalar(line_ int, value_ string, quoted_ bool) *ConfigNode {
+	return &ConfigNode{Kind: CONFIG_SCALAR, Line: line_, Value: value_, Quoted: quoted_}
+}
+
+func (node *ConfigNode) set(key string, value *ConfigNode) error {
+	if _, exists := node.Fields[key]; exists {
+		return fmt.Errorf("line %d : duplicate key %s", value.Line, key)
+	}
+	node.Keys = append(node.Keys, key)
+	node.Fields[key] = value
+	return nil
+}
+
+func (node *ConfigNode) describe() string {
+	switch node.Kind {
+	case CONFIG_MAP:
+		return "a mapping"
+	case CONFIG_LIST:
+		return "a list"
+	case CONFIG_NULL:
+		return "null"
+	}
+	if node.Quoted {
+		return strconv.Quote(node.Value)
+	}
+	return node.Value
+}
+
+// configFormat picks the format from the file extension, json is the default
+func configFormat(fileName string) string {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".yaml", ".yml":
+		return CONFIG_FORMAT_YAML
+	case ".toml":
+		return CONFIG_FORMAT_TOML
+	}
+	return CONFIG_FORMAT_JSON
+}
+
+func parseConfigDocument(format string, content string) (*ConfigNode, error) {
+	switch format {
+	case CONFIG_FORMAT_YAML:
+		return parseYamlDocument(content)
+	case CONFIG_FORMAT_TOML:
+		return parseTomlDocument(content)
+	case CONFIG_FORMAT_JSON:
+		return parseJsonDocument(content)
+	}
+	return nil, errors.New("unsupported config format [" + format + "], expected " + CONFIG_FORMAT_JSON + ", " + CONFIG_FORMAT_YAML + " or " + CONFIG_FORMAT_TOML)
+}
+
+// parseConfig reads a config document and decodes it, unknown fields are errors
+func parseConfig(format string, content string) (*PostgresToGoConfig, error) {
+	document, err := parseConfigDocument(format, content)
+	if err != nil {
+		return nil, err
+	}
+	config := new(PostgresToGoConfig)
+	err = decodeConfigNode(document, reflect.ValueOf(config).Elem(), "")
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// lineOffsets returns the offset of the start of every line
+func lineOffsets(content string) []int {
+	offsets := []int{0}
+	for index, r := range content {
+		if r == '\n' {
+			offsets = append(offsets, index+1)
+		}
+	}
+	return offsets
+}
+
+func lineAt(offsets []int, offset int64) int {
+	return sort.Search(len(offsets), func(i int) bool { return int64(offsets[i]) > offset })
+}
+
+func parseJsonDocument(content string) (*ConfigNode, error) {
+	offsets := lineOffsets(content)
+	decoder := json.NewDecoder(strings.NewReader(content))
+	decoder.UseNumber()
+	node, err := parseJsonValue(decoder, offsets)
+	if err == nil {
+		_, err = decoder.Token()
+		if err == nil {
+			return nil, fmt.Errorf("line %d : unexpected content after the document", lineAt(offsets, decoder.InputOffset()))
+		}
+		if err == io.EOF {
+			err = nil
+		}
+	}
+	if err != nil {
+		if syntaxError, ok := err.(*json.SyntaxError); ok {
+			return nil, fmt.Errorf("line %d : %s", lineAt(offsets, syntaxError.Offset-1), syntaxError.Error())
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, fmt.Errorf("line %d : unexpected end of document", len(offsets))
+		}
+		return nil, err
+	}
+	return node, nil
+}
+
+// a json token never spans lines, the line of its end is the line of its start
+func parseJsonValue(decoder *json.Decoder, offsets []int) (*ConfigNode, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	line := lineAt(offsets, decoder.InputOffset()-1)
+	switch value := token.(type) {
+	case json.Delim:
+		if value == '{' {
+			node := NewConfigMap(line)
+			for decoder.More() {
+				keyToken, err := decoder.Token()
+				if err != nil {
+					return nil, err
+				}
+				keyLine := lineAt(offsets, decoder.InputOffset()-1)
+				child, err := parseJsonValue(decoder, offsets)
+				if err != nil {
+					return nil, err
+				}
+				child.Line = keyLine
+				err = node.set(keyToken.(string), child)
+				if err != nil {
+					return nil, err
+				}
+			}
+			_, err = decoder.Token()
+			return node, err
+		}
+		if value == '[' {
+			node := NewConfigList(line)
+			for decoder.More() {
+				child, err := parseJsonValue(decoder, offsets)
+				if err != nil {
+					return nil, err
+				}
+				node.Items = append(node.Items, child)
+			}
+			_, err = decoder.Token()
+			return node, err
+		}
+		return nil, fmt.Errorf("line %d : unexpected %s", line, value)
+	case string:
+		return NewConfigScalar(line, value, true), nil
+	case json.Number:
+		return NewConfigScalar(line, value.String(), false), nil
+	case bool:
+		return NewConfigScalar(line, strconv.FormatBool(value), false), nil
+	}
+	return &ConfigNode{Kind: CONFIG_NULL, Line: line}, nil
+}
+
+// configFieldName is the json tag name, the same name is used by every format
+func configFieldName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	name := strings.Split(tag, ",")[0]
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+func joinConfigPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// closestName returns the known name at an edit distance of at most 2, to
+// suggest a fix for a misspelled field
+func closestName(name string, known []string) string {
+	best := ""
+	bestDistance := 3
+	for _, candidate := range known {
+		distance := editDistance(strings.ToLower(name), strings.ToLower(candidate))
+		if distance < bestDistance {
+			best = candidate
+			bestDistance = distance
+		}
+	}
+	return best
+}
+
+func editDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = previous[j-1] + cost
+			if previous[j]+1 < current[j] {
+				current[j] = previous[j] + 1
+			}
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// decodeConfigNode stores node into value following the json tags of the
+// config structs
+func decodeConfigNode(node *ConfigNode, value reflect.Value, path string) error {
+	if node.Kind == CONFIG_NULL {
+		return nil
+	}
+	where := fmt.Sprintf("line %d : %s", node.Line, path)
+	if path == "" {
+		where = fmt.Sprintf("line %d : document", node.Line)
+	}
+	switch value.Kind() {
+	case reflect.Struct:
+		if node.Kind != CONFIG_MAP {
+			return fmt.Errorf("%s : expected a mapping, found %s", where, node.describe())
+		}
+		fields := make(map[string]int)
+		names := make([]string, 0, 0)
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.PkgPath != "" || field.Tag.Get("json") == "-" {
+				continue
+			}
+			fields[configFieldName(field)] = i
+			names = append(names, configFieldName(field))
+		}
+		for _, key := range node.Keys {
+			child := node.Fields[key]
+			index, exists := fields[key]
+			if !exists {
+				message := fmt.Sprintf("line %d : unknown field %s", child.Line, joinConfigPath(path, key))
+				if suggestion := closestName(key, names); suggestion != "" {
+					message += ", did you mean " + suggestion + " ?"
+				}
+				return errors.New(message)
+			}
+			err := decodeConfigNode(child, value.Field(index), joinConfigPath(path, key))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		if node.Kind != CONFIG_MAP {
+			return fmt.Errorf("%s : expected a mapping, found %s", where, node.describe())
+		}
+		if value.IsNil() {
+			value.Set(reflect.MakeMap(value.Type()))
+		}
+		for _, key := range node.Keys {
+			element := reflect.New(value.Type().Elem()).Elem()
+			err := decodeConfigNode(node.Fields[key], element, joinConfigPath(path, key))
+			if err != nil {
+				return err
+			}
+			value.SetMapIndex(reflect.ValueOf(key), element)
+		}
+		return nil
+	case reflect.Slice:
+		if node.Kind != CONFIG_LIST {
+			return fmt.Errorf("%s : expected a list, found %s", where, node.describe())
+		}
+		slice := reflect.MakeSlice(value.Type(), len(node.Items), len(node.Items))
+		for i, item := range node.Items {
+			err := decodeConfigNode(item, slice.Index(i), fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return err
+			}
+		}
+		value.Set(slice)
+		return nil
+	}
+
+	if node.Kind != CONFIG_SCALAR {
+		return fmt.Errorf("%s : expected a single value, found %s", where, node.describe())
+	}
+	switch value.Kind() {
+	case reflect.String:
+		// an unquoted number or boolean is fine for a string, ex a numeric password
+		value.SetString(node.Value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(node.Value)
+		if err != nil || node.Quoted {
+			return fmt.Errorf("%s : expected true or false, found %s", where, node.describe())
+		}
+		value.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		i, err := strconv.ParseInt(strings.Replace(node.Value, "_", "", -1), 10, 64)
+		if err != nil || node.Quoted {
+			return fmt.Errorf("%s : expected an integer, found %s", where, node.describe())
+		}
+		value.SetInt(i)
+	default:
+		return fmt.Errorf("%s : unsupported config field type %s", where, value.Type())
+	}
+	return nil
+}
+
+// trimConfigComment removes a # comment outside of quotes
+func trimConfigComment(line string) string {
+	var quote rune
+	escaped := false
+	for index, r := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case quote == '"' && r == '\\':
+			escaped = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '#' && (index == 0 || line[index-1] == ' ' || line[index-1] == '\t'):
+			return strings.TrimRight(line[:index], " \t")
+		}
+	}
+	return strings.TrimRight(line, " \t\r")
+}
+
+// configValueError is an error of the value at the dotted key path, the
+// value itself is left out so a password never reaches the console or the log
+func configValueError(number int, path string, message string) error {
+	if path == "" {
+		return fmt.Errorf("line %d : %s", number, message)
+	}
+	return fmt.Errorf("line %d : %s : %s", number, path, message)
+}
+
+// configPath appends key to the dotted key path
+func configPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// unquoteConfigString reads a double or single quoted string at the start of
+// s and returns it with the rest of s, yaml doubles a single quote inside a
+// single quoted string while toml can not have one at all
+func unquoteConfigString(s string, doubledQuotes bool) (string, string, error) {
+	var buffer bytes.Buffer
+	quote := s[0]
+	for index := 1; index < len(s); index++ {
+		c := s[index]
+		if quote == '\'' && c == '\'' {
+			if doubledQuotes && index+1 < len(s) && s[index+1] == '\'' {
+				buffer.WriteByte('\'')
+				index++
+				continue
+			}
+			return buffer.String(), s[index+1:], nil
+		}
+		if quote == '"' && c == '"' {
+			return buffer.String(), s[index+1:], nil
+		}
+		if quote == '"' && c == '\\' && index+1 < len(s) {
+			index++
+			switch s[index] {
+			case 'n':
+				buffer.WriteByte('\n')
+			case 't':
+				buffer.WriteByte('\t')
+			case 'r':
+				buffer.WriteByte('\r')
+			case '"', '\\', '/':
+				buffer.WriteByte(s[index])
+			case 'u':
+				if index+4 < len(s) {
+					code, err := strconv.ParseUint(s[index+1:index+5], 16, 32)
+					if err == nil {
+						buffer.WriteRune(rune(code))
+						index += 4
+						continue
+					}
+				}
+				return "", "", errors.New("invalid unicode escape")
+			default:
+				return "", "", errors.New("invalid escape sequence")
+			}
+			continue
+		}
+		buffer.WriteByte(c)
+	}
+	return "", "", errors.New("unterminated string")
+}
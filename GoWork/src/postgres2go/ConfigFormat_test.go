@@ -0,0 +1,247 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestConfigTemplatesAreValid(t *testing.T) {
+	yamlConfig, err := parseConfig(CONFIG_FORMAT_YAML, CONFIG_TEMPLATE_YAML)
+	if err != nil {
+		t.Fatalf("yaml template : %s", err)
+	}
+	tomlConfig, err := parseConfig(CONFIG_FORMAT_TOML, CONFIG_TEMPLATE_TOML)
+	if err != nil {
+		t.Fatalf("toml template : %s", err)
+	}
+	// toml has no empty mapping literal, the yaml {} decodes to an empty map
+	tomlConfig.Output.Naming.Singulars = yamlConfig.Output.Naming.Singulars
+	tomlConfig.Tables = yamlConfig.Tables
+	if !reflect.DeepEqual(yamlConfig, tomlConfig) {
+		t.Errorf("yaml and toml templates differ :\n%+v\n%+v", yamlConfig, tomlConfig)
+	}
+	if err = yamlConfig.validate(); err != nil {
+		t.Error(err)
+	}
+	if _, err = NewOutputLayout(yamlConfig.Output); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestConfigFormatsDecodeTheSameConfig(t *testing.T) {
+	documents := map[string]string{
+		CONFIG_FORMAT_JSON: `{
+	"login": "app",
+	"password": "it's # secret",
+	"host": "db.local",
+	"port": 6432,
+	"db": "app",
+	"output": {"layout": "split", "importPath": "example.com/gen", "naming": {"initialisms": ["SKU"]}},
+	"tables": {"user-accounts": {"goName": "Account", "columns": {"password_hash": {"omitFromJson": true}}}}
+}`,
+		CONFIG_FORMAT_YAML: `# comment
+login: app
+password: "it's # secret" # comment
+host: db.local
+port: 6432
+db: app
+output:
+  layout: split
+  importPath: example.com/gen
+  naming:
+    initialisms:
+    - SKU
+tables:
+  "user-accounts":
+    goName: Account
+    columns:
+      password_hash: {omitFromJson: true}
+`,
+		CONFIG_FORMAT_TOML: `login = "app"
+password = "it's # secret" # comment
+host = "db.local"
+port = 6_432
+db = "app"
+
+[output]
+layout = "split"
+importPath = "example.com/gen"
+naming.initialisms = [
+	"SKU", # comment
+]
+
+[tables."user-accounts"]
+goName = "Account"
+columns.password_hash = { omitFromJson = true }
+`,
+	}
+	var expected *PostgresToGoConfig
+	for _, format := range []string{CONFIG_FORMAT_JSON, CONFIG_FORMAT_YAML, CONFIG_FORMAT_TOML} {
+		config, err := parseConfig(format, documents[format])
+		if err != nil {
+			t.Errorf("%s : %s", format, err)
+			continue
+		}
+		if expected == nil {
+			expected = config
+			if config.Password != "it's # secret" || !config.Tables["user-accounts"].Columns["password_hash"].OmitFromJson {
+				t.Errorf("%s : unexpected config %+v", format, config)
+			}
+		} else if !reflect.DeepEqual(expected, config) {
+			t.Errorf("%s decodes to\n%+v\nexpected\n%+v", format, config, expected)
+		}
+	}
+}
+
+func TestConfigErrorsHaveLineNumbers(t *testing.T) {
+	tests := []struct {
+		format   string
+		document string
+		expected string
+	}{
+		{CONFIG_FORMAT_JSON, "{\n\"login\": \"a\",\n\"pasword\": \"b\"\n}", "line 3 : unknown field pasword, did you mean password ?"},
+		{CONFIG_FORMAT_JSON, "{\n\"output\": {\n\"layout\": \"flat\",\n\"usercode\": true}}", "line 4 : unknown field output.usercode, did you mean userCode ?"},
+		{CONFIG_FORMAT_JSON, "{\n\"port\": \"5432\"\n}", "line 2 : port : expected an integer"},
+		{CONFIG_FORMAT_JSON, "{\n\"login\": \"a\",,\n}", "line 2 : "},
+		{CONFIG_FORMAT_YAML, "login: a\noutput:\n  directory: out\n  layuot: flat\n", "line 4 : unknown field output.layuot, did you mean layout ?"},
+		{CONFIG_FORMAT_YAML, "login: a\n\noutput:\n  userCode: maybe\n", "line 4 : output.userCode : expected true or false"},
+		{CONFIG_FORMAT_YAML, "login: a\nlogin: b\n", "line 2 : duplicate key login"},
+		{CONFIG_FORMAT_YAML, "output:\n  directory: out\n    layout: flat\n", "line 3 : unexpected indentation"},
+		{CONFIG_FORMAT_YAML, "tables:\n  users:\n    columns:\n      email:\n        jsonname: mail\n", "line 5 : unknown field tables.users.columns.email.jsonname, did you mean jsonName ?"},
+		{CONFIG_FORMAT_TOML, "login = \"a\"\n\n[output]\nfileNameing = \"snake\"\n", "line 4 : unknown field output.fileNameing, did you mean fileNaming ?"},
+		{CONFIG_FORMAT_TOML, "login = a\n", "line 1 : login : invalid value, strings must be quoted"},
+		{CONFIG_FORMAT_TOML, "[output]\n[output]\n", "line 2 : table [output] defined twice"},
+		{CONFIG_FORMAT_TOML, "host = \"h\"\nunknown = 1\n", "line 2 : unknown field unknown"},
+		{CONFIG_FORMAT_YAML, "login: a\npassword: se cret: x\n", "line 2 : password : a plain value can not hold ': ', quote it"},
+		{CONFIG_FORMAT_YAML, "password: secret:\n", "line 1 : password : a plain value can not hold ': ', quote it"},
+		{CONFIG_FORMAT_YAML, "login: a\nhost\n", "line 2 : expected key: value"},
+		{CONFIG_FORMAT_YAML, "output:\n  layout: @flat\n", "line 2 : output.layout : a plain value can not start with a yaml indicator, quote it"},
+		{CONFIG_FORMAT_YAML, "output:\n  naming:\n    initialisms: [SKU, API\n", "line 3 : output.naming.initialisms : unterminated flow sequence"},
+		{CONFIG_FORMAT_YAML, "tables: {users: {goName: a: b}}\n", "line 1 : tables.users.goName : a plain value can not hold ': ', quote it"},
+		{CONFIG_FORMAT_YAML, "login: \"a\" b\n", "line 1 : login : unexpected text after the value"},
+		{CONFIG_FORMAT_YAML, "login: !!str a\n", "line 1 : login : yaml anchors, aliases and tags are not supported"},
+		{CONFIG_FORMAT_YAML, "login: |\n  a\n", "line 1 : login : yaml block scalars are not supported"},
+		{CONFIG_FORMAT_YAML, "output:\n- flat\n", "line 1 : output : expected a mapping, found a list"},
+		{CONFIG_FORMAT_TOML, "port = 54__32\n", "line 1 : port : invalid value, strings must be quoted"},
+		{CONFIG_FORMAT_TOML, "port = 1.2.3\n", "line 1 : port : invalid value, strings must be quoted"},
+		{CONFIG_FORMAT_TOML, "login = \"a\" \"b\"\n", "line 1 : login : unexpected text after the value"},
+		{CONFIG_FORMAT_TOML, "[output]\nlayout = \"\"\"flat\"\"\"\n", "line 2 : output.layout : toml multi line strings are not supported"},
+		{CONFIG_FORMAT_TOML, "tables.users = { goName = \"A\", columns = { id = { goName = B } } }\n", "line 1 : tables.users.columns.id.goName : invalid value, strings must be quoted"},
+		{CONFIG_FORMAT_TOML, "[output\n", "line 1 : unterminated table header"},
+		{CONFIG_FORMAT_TOML, "login\n", "line 1 : expected key = value"},
+	}
+	for _, test := range tests {
+		_, err := parseConfig(test.format, test.document)
+		if err == nil {
+			t.Errorf("%s %q : no error, expected %s", test.format, test.document, test.expected)
+		} else if !strings.HasPrefix(err.Error(), test.expected) {
+			t.Errorf("%s %q : error %q, expected %s", test.format, test.document, err.Error(), test.expected)
+		}
+	}
+}
+
+// the password may be what makes a line malformed, a parse error must not quote it
+func TestConfigErrorsLeaveOutValues(t *testing.T) {
+	tests := []struct {
+		format   string
+		document string
+	}{
+		{CONFIG_FORMAT_YAML, "password: \"hunter2\n"},
+		{CONFIG_FORMAT_YAML, "password: 'hunter2\n"},
+		{CONFIG_FORMAT_YAML, "password: \"hunter2\\q\"\n"},
+		{CONFIG_FORMAT_YAML, "password: hunter2: x\n"},
+		{CONFIG_FORMAT_YAML, "password: \"hunter2\" x\n"},
+		{CONFIG_FORMAT_YAML, "login: a\nhunter2\n"},
+		{CONFIG_FORMAT_YAML, "password: [hunter2\n"},
+		{CONFIG_FORMAT_TOML, "password = hunter2\n"},
+		{CONFIG_FORMAT_TOML, "password = \"hunter2\n"},
+		{CONFIG_FORMAT_TOML, "password = 'hunter2\n"},
+		{CONFIG_FORMAT_TOML, "password = \"hunter2\" x\n"},
+		{CONFIG_FORMAT_TOML, "password = \"\"\"hunter2\"\"\"\n"},
+		{CONFIG_FORMAT_TOML, "\"hunter2\"\n"},
+	}
+	for _, test := range tests {
+		_, err := parseConfig(test.format, test.document)
+		if err == nil {
+			t.Errorf("%s %q : no error", test.format, test.document)
+		} else if strings.Contains(err.Error(), "hunter2") {
+			t.Errorf("%s %q : error %q quotes the value", test.format, test.document, err.Error())
+		}
+	}
+}
+
+func TestConfigScalars(t *testing.T) {
+	tests := []struct {
+		format   string
+		document string
+		expected string
+	}{
+		{CONFIG_FORMAT_YAML, "password: plain text\n", "plain text"},
+		{CONFIG_FORMAT_YAML, "password: a#b # comment\n", "a#b"},
+		{CONFIG_FORMAT_YAML, "password: \"# not a comment\"\n", "# not a comment"},
+		{CONFIG_FORMAT_YAML, "password: 'it''s'\n", "it's"},
+		{CONFIG_FORMAT_YAML, "password: \"a: b\\t\\\"c\\\"\"\n", "a: b\t\"c\""},
+		{CONFIG_FORMAT_YAML, "password: \"\\u00e9\"\n", "é"},
+		{CONFIG_FORMAT_YAML, "password: postgres://u:p@h/db\n", "postgres://u:p@h/db"},
+		{CONFIG_FORMAT_YAML, "password: ~\n", ""},
+		{CONFIG_FORMAT_YAML, "---\npassword: a, b\n...\nignored: x\n", "a, b"},
+		{CONFIG_FORMAT_TOML, "password = \"a\\\"b\" # comment\n", "a\"b"},
+		{CONFIG_FORMAT_TOML, "password = 'C:\\dir # not a comment'\n", "C:\\dir # not a comment"},
+		{CONFIG_FORMAT_TOML, "\"password\" = \"quoted key\"\n", "quoted key"},
+	}
+	for _, test := range tests {
+		config, err := parseConfig(test.format, test.document)
+		if err != nil {
+			t.Errorf("%s %q : %s", test.format, test.document, err)
+		} else if config.Password != test.expected {
+			t.Errorf("%s %q : password %q, expected %q", test.format, test.document, config.Password, test.expected)
+		}
+	}
+}
+
+func TestConfigNesting(t *testing.T) {
+	documents := map[string]string{
+		CONFIG_FORMAT_YAML: `output:
+  naming:
+    initialisms:
+    - SKU
+    - "API"
+    singulars: {data: datum}
+tables:
+  users:
+    columns:
+      email:
+        jsonName: mail
+      id: {goName: UserID, readOnly: true}
+  orders: {goName: Purchase}
+`,
+		CONFIG_FORMAT_TOML: `[output.naming]
+initialisms = ["SKU", 'API']
+singulars = { data = "datum" }
+
+[tables.users.columns.email]
+jsonName = "mail"
+
+[tables.users.columns]
+id = { goName = "UserID", readOnly = true }
+
+[tables]
+orders.goName = "Purchase"
+`,
+	}
+	for format, document := range documents {
+		config, err := parseConfig(format, document)
+		if err != nil {
+			t.Errorf("%s : %s", format, err)
+			continue
+		}
+		naming := config.Output.Naming
+		columns := config.Tables["users"].Columns
+		if !reflect.DeepEqual(naming.Initialisms, []string{"SKU", "API"}) || naming.Singulars["data"] != "datum" ||
+			columns["email"].JsonName != "mail" || columns["id"].GoName != "UserID" || !columns["id"].ReadOnly ||
+			config.Tables["orders"].GoName != "Purchase" {
+			t.Errorf("%s : unexpected config %+v", format, config)
+		}
+	}
+}
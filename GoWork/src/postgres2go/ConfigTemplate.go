@@ -0,0 +1,131 @@
+package main
+
+// the defaults written by config init, every field is documented, keep both
+// formats in sync with PostgresToGoConfig
+
+const CONFIG_TEMPLATE_YAML = `# postgres2go configuration
+
+# database connection, login, host and db are required
+login: postgres
+# the password never shows in the output or the log
+password: ""
+host: localhost
+port: 5432
+# extra libpq key=value parameters, ex sslmode=require connect_timeout=10
+parameters: sslmode=disable
+db: postgres
+
+output:
+  # directory of the generated files, -output overrides it
+  directory: ./outputs
+  # go package of the generated files with the flat layout
+  packageName: main
+  # flat : every file in directory
+  # split : model/, dto/ and dao/ sub packages, importPath is then required
+  layout: flat
+  # go import path of directory, ex example.com/app/generated
+  importPath: ""
+  # camel : UserAccount.go, snake : user_account.go
+  fileNaming: camel
+  # package names of the split layout
+  modelPackage: model
+  dtoPackage: dto
+  daoPackage: dao
+  # emit BEGIN USER CODE / END USER CODE regions kept across generations
+  userCode: false
+  naming:
+    # initialisms written in upper case, added to ID, URL, HTTP, JSON, ...
+    initialisms: []
+    # default initialisms to write as plain words
+    ignoreInitialisms: []
+    # singular of a table name or of its last word, ex data: datum
+    singulars: {}
+    # keep users as Users instead of User
+    keepPluralNames: false
+
+# per table and per column overrides, keyed by the sql names
+tables: {}
+#  users:
+#    goName: Member
+#    # column checked by update, default version (integer) or updated_at (timestamp)
+#    versionColumn: revision
+#    # nullable timestamp of the soft delete, default deleted_at
+#    softDeleteColumn: archived_at
+#    # timestamps set to now() by create and update, default created_at and updated_at
+#    createdAtColumn: inserted_at
+#    updatedAtColumn: modified_at
+#    columns:
+#      password_hash:
+#        omitFromJson: true
+#      internal_note:
+#        omit: true
+#      created_at:
+#        readOnly: true
+#      settings:
+#        goType: encoding/json.RawMessage
+#      email:
+#        goName: EmailAddress
+#        jsonName: mail
+`
+
+const CONFIG_TEMPLATE_TOML = `# postgres2go configuration
+
+# database connection, login, host and db are required
+login = "postgres"
+# the password never shows in the output or the log
+password = ""
+host = "localhost"
+port = 5432
+# extra libpq key=value parameters, ex sslmode=require connect_timeout=10
+parameters = "sslmode=disable"
+db = "postgres"
+
+[output]
+# directory of the generated files, -output overrides it
+directory = "./outputs"
+# go package of the generated files with the flat layout
+packageName = "main"
+# flat : every file in directory
+# split : model/, dto/ and dao/ sub packages, importPath is then required
+layout = "flat"
+# go import path of directory, ex example.com/app/generated
+importPath = ""
+# camel : UserAccount.go, snake : user_account.go
+fileNaming = "camel"
+# package names of the split layout
+modelPackage = "model"
+dtoPackage = "dto"
+daoPackage = "dao"
+# emit BEGIN USER CODE / END USER CODE regions kept across generations
+userCode = false
+
+[output.naming]
+# initialisms written in upper case, added to ID, URL, HTTP, JSON, ...
+initialisms = []
+# default initialisms to write as plain words
+ignoreInitialisms = []
+# keep users as Users instead of User
+keepPluralNames = false
+
+# singular of a table name or of its last word
+[output.naming.singulars]
+# data = "datum"
+
+# per table and per column overrides, keyed by the sql names
+[tables]
+# [tables.users]
+# goName = "Member"
+# # column checked by update, default version (integer) or updated_at (timestamp)
+# versionColumn = "revision"
+# # nullable timestamp of the soft delete, default deleted_at
+# softDeleteColumn = "archived_at"
+# # timestamps set to now() by create and update, default created_at and updated_at
+# createdAtColumn = "inserted_at"
+# updatedAtColumn = "modified_at"
+# [tables.users.columns]
+# password_hash = { omitFromJson = true }
+# internal_note = { omit = true }
+# created_at = { readOnly = true }
+# settings = { goType = "encoding/json.RawMessage" }
+# email = { goName = "EmailAddress", jsonName = "mail" }
+`
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// tomlParser reads the subset of toml a config needs : tables, dotted and
+// quoted keys, basic and literal strings, integers, floats, booleans, arrays
+// and inline tables, multi line strings, dates and arrays of tables are not
+// supported
+type tomlParser struct {
+	number int
+	path   string // dotted key of the value being read, for the errors
+}
+
+// decimal integers and floats, an underscore between two digits
+var tomlNumberRegexp = regexp.MustCompile(`^[+-]?(0|[1-9](_?[0-9])*)(\.[0-9](_?[0-9])*)?([eE][+-]?[0-9](_?[0-9])*)?$`)
+
+func parseTomlDocument(content string) (*ConfigNode, error) {
+	root := NewConfigMap(1)
+	current := root
+	tablePath := ""
+	defined := make(map[*ConfigNode]bool)
+	lines := strings.Split(content, "\n")
+	for i := 0; i < len(lines); i++ {
+		parser := &tomlParser{number: i + 1}
+		line := strings.TrimSpace(trimConfigComment(lines[i]))
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "[[") {
+			return nil, fmt.Errorf("line %d : toml arrays of tables are not supported", parser.number)
+		}
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d : unterminated table header %s", parser.number, line)
+			}
+			keys, err := parser.parseKey(strings.TrimSpace(line[1 : len(line)-1]))
+			if err != nil {
+				return nil, err
+			}
+			current, err = parser.table(root, keys)
+			if err != nil {
+				return nil, err
+			}
+			if defined[current] {
+				return nil, fmt.Errorf("line %d : table [%s] defined twice", parser.number, strings.Join(keys, "."))
+			}
+			defined[current] = true
+			tablePath = strings.Join(keys, ".")
+			continue
+		}
+
+		equal := parser.keyEnd(line)
+		if equal < 0 {
+			// the line may be a misplaced value, it is not printed
+			return nil, fmt.Errorf("line %d : expected key = value", parser.number)
+		}
+		keys, err := parser.parseKey(strings.TrimSpace(line[:equal]))
+		if err != nil {
+			return nil, err
+		}
+		parser.path = configPath(tablePath, strings.Join(keys, "."))
+		value := strings.TrimSpace(line[equal+1:])
+		// an array may continue on the next lines until its brackets balance
+		for strings.HasPrefix(value, "[") && !tomlBalanced(value) && i+1 < len(lines) {
+			i++
+			value += " " + strings.TrimSpace(trimConfigComment(lines[i]))
+		}
+		node, rest, err := parser.parseValue(value)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(rest) != "" {
+			return nil, configValueError(parser.number, parser.path, "unexpected text after the value")
+		}
+		err = parser.assign(current, keys, node)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return root, nil
+}
+
+// tomlBalanced tells whether the brackets of an array are closed, outside of strings
+func tomlBalanced(value string) bool {
+	depth := 0
+	var quote byte
+	for index := 0; index < len(value); index++ {
+		c := value[index]
+		if quote != 0 {
+			if c == '\\' && quote == '"' {
+				index++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'':
+			quote = c
+		case '[', '{':
+			depth++
+		case ']', '}':
+			depth--
+		}
+	}
+	return depth <= 0
+}
+
+// keyEnd returns the index of the = ending the key, outside of quotes
+func (parser *tomlParser) keyEnd(line string) int {
+	var quote byte
+	for index := 0; index < len(line); index++ {
+		c := line[index]
+		if quote != 0 {
+			if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		if c == '"' || c == '\'' {
+			quote = c
+		} else if c == '=' {
+			return index
+		}
+	}
+	return -1
+}
+
+// parseKey splits a dotted key, ex tables."user-x".goName
+func (parser *tomlParser) parseKey(key string) ([]string, error) {
+	keys := make([]string, 0, 0)
+	rest := strings.TrimSpace(key)
+	for {
+		if rest == "" {
+			return nil, fmt.Errorf("line %d : invalid key %s", parser.number, key)
+		}
+		part := ""
+		if rest[0] == '"' || rest[0] == '\'' {
+			unquoted, after, err := unquoteConfigString(rest, false)
+			if err != nil {
+				return nil, fmt.Errorf("line %d : %s", parser.number, err.Error())
+			}
+			part = unquoted
+			rest = strings.TrimSpace(after)
+		} else {
+			end := strings.IndexAny(rest, ". ")
+			if end < 0 {
+				end = len(rest)
+			}
+			part = rest[:end]
+			for _, r := range part {
+				if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-') {
+					return nil, fmt.Errorf("line %d : invalid bare key %s, quote it", parser.number, part)
+				}
+			}
+			rest = strings.TrimSpace(rest[end:])
+		}
+		keys = append(keys, part)
+		if rest == "" {
+			return keys, nil
+		}
+		if rest[0] != '.' {
+			return nil, fmt.Errorf("line %d : invalid key %s", parser.number, key)
+		}
+		rest = strings.TrimSpace(rest[1:])
+	}
+}
+
+// table returns the table of a header, creating the missing parents
+func (parser *tomlParser) table(root *ConfigNode, keys []string) (*ConfigNode, error) {
+	current := root
+	for _, key := range keys {
+		child, exists := current.Fields[key]
+		if !exists {
+			child = NewConfigMap(parser.number)
+			current.set(key, child)
+		} else if child.Kind != CONFIG_MAP {
+			return nil, fmt.Errorf("line %d : key %s is already a value", parser.number, key)
+		}
+		current = child
+	}
+	return current, nil
+}
+
+func (parser *tomlParser) assign(table *ConfigNode, keys []string, node *ConfigNode) error {
+	parent, err := parser.table(table, keys[:len(keys)-1])
+	if err != nil {
+		return err
+	}
+	return parent.set(keys[len(keys)-1], node)
+}
+
+func (parser *tomlParser) parseValue(value string) (*ConfigNode, string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, "", configValueError(parser.number, parser.path, "missing value")
+	}
+	switch value[0] {
+	case '"', '\'':
+		if strings.HasPrefix(value, "\"\"\"") || strings.HasPrefix(value, "'''") {
+			return nil, "", configValueError(parser.number, parser.path, "toml multi line strings are not supported")
+		}
+		unquoted, rest, err := unquoteConfigString(value, false)
+		if err != nil {
+			return nil, "", configValueError(parser.number, parser.path, err.Error())
+		}
+		return NewConfigScalar(parser.number, unquoted, true), rest, nil
+	case '[':
+		node := NewConfigList(parser.number)
+		rest := strings.TrimSpace(value[1:])
+		for !strings.HasPrefix(rest, "]") {
+			item, after, err := parser.parseValue(rest)
+			if err != nil {
+				return nil, "", err
+			}
+			node.Items = append(node.Items, item)
+			rest = strings.TrimSpace(after)
+			if strings.HasPrefix(rest, ",") {
+				rest = strings.TrimSpace(rest[1:])
+			} else if !strings.HasPrefix(rest, "]") {
+				return nil, "", configValueError(parser.number, parser.path, "unterminated array")
+			}
+		}
+		return node, rest[1:], nil
+	case '{':
+		node := NewConfigMap(parser.number)
+		rest := strings.TrimSpace(value[1:])
+		for !strings.HasPrefix(rest, "}") {
+			equal := parser.keyEnd(rest)
+			if equal < 0 {
+				return nil, "", configValueError(parser.number, parser.path, "unterminated inline table")
+			}
+			keys, err := parser.parseKey(rest[:equal])
+			if err != nil {
+				return nil, "", err
+			}
+			path := parser.path
+			parser.path = configPath(path, strings.Join(keys, "."))
+			item, after, err := parser.parseValue(rest[equal+1:])
+			parser.path = path
+			if err != nil {
+				return nil, "", err
+			}
+			err = parser.assign(node, keys, item)
+			if err != nil {
+				return nil, "", err
+			}
+			rest = strings.TrimSpace(after)
+			if strings.HasPrefix(rest, ",") {
+				rest = strings.TrimSpace(rest[1:])
+			} else if !strings.HasPrefix(rest, "}") {
+				return nil, "", configValueError(parser.number, parser.path, "unterminated inline table")
+			}
+		}
+		return node, rest[1:], nil
+	}
+	end := strings.IndexAny(value, ",]} \t")
+	if end < 0 {
+		end = len(value)
+	}
+	bare := value[:end]
+	if bare != "true" && bare != "false" && !tomlNumberRegexp.MatchString(bare) {
+		return nil, "", configValueError(parser.number, parser.path, "invalid value, strings must be quoted")
+	}
+	return NewConfigScalar(parser.number, strings.TrimPrefix(bare, "+"), false), value[end:], nil
+}
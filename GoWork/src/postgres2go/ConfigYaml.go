@@ -0,0 +1,320 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// yamlLine is a significant line of a yaml document, comments removed
+type yamlLine struct {
+	number  int
+	indent  int
+	content string
+}
+
+// yamlParser reads the block subset of yaml a config needs : nested
+// mappings, sequences, quoted and plain scalars and single line flow
+// collections, anchors, tags and block scalars are not supported
+type yamlParser struct {
+	lines []yamlLine
+	index int
+}
+
+func parseYamlDocument(content string) (*ConfigNode, error) {
+	parser := &yamlParser{lines: make([]yamlLine, 0, 0)}
+	for i, raw := range strings.Split(content, "\n") {
+		line := trimConfigComment(raw)
+		trimmed := strings.TrimLeft(line, " ")
+		if trimmed == "" || trimmed == "---" {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, fmt.Errorf("line %d : tabs are not allowed in yaml indentation", i+1)
+		}
+		if trimmed == "..." {
+			break
+		}
+		if strings.HasPrefix(trimmed, "%") {
+			return nil, fmt.Errorf("line %d : yaml directives are not supported", i+1)
+		}
+		parser.lines = append(parser.lines, yamlLine{number: i + 1, indent: len(line) - len(trimmed), content: trimmed})
+	}
+	if len(parser.lines) == 0 {
+		return NewConfigMap(1), nil
+	}
+	node, err := parser.parseBlock(parser.lines[0].indent, "")
+	if err != nil {
+		return nil, err
+	}
+	if parser.index < len(parser.lines) {
+		line := parser.lines[parser.index]
+		return nil, fmt.Errorf("line %d : unexpected indentation", line.number)
+	}
+	return node, nil
+}
+
+func isYamlSequenceItem(content string) bool {
+	return content == "-" || strings.HasPrefix(content, "- ")
+}
+
+// parseBlock reads the mapping or sequence at indent, path is its dotted key
+// for the errors
+func (parser *yamlParser) parseBlock(indent int, path string) (*ConfigNode, error) {
+	if isYamlSequenceItem(parser.lines[parser.index].content) {
+		return parser.parseSequence(indent, path)
+	}
+	return parser.parseMapping(indent, path)
+}
+
+func (parser *yamlParser) parseSequence(indent int, path string) (*ConfigNode, error) {
+	node := NewConfigList(parser.lines[parser.index].number)
+	for parser.index < len(parser.lines) {
+		line := parser.lines[parser.index]
+		if line.indent < indent {
+			break
+		}
+		if line.indent > indent {
+			return nil, fmt.Errorf("line %d : unexpected indentation", line.number)
+		}
+		if !isYamlSequenceItem(line.content) {
+			break
+		}
+		rest := strings.TrimLeft(strings.TrimPrefix(line.content, "-"), " ")
+		if rest == "" {
+			parser.index++
+			item, err := parser.parseNested(indent, line.number, false, path)
+			if err != nil {
+				return nil, err
+			}
+			node.Items = append(node.Items, item)
+			continue
+		}
+		if _, _, isPair := splitYamlPair(rest); isPair || isYamlSequenceItem(rest) {
+			// - key: value starts a mapping indented at the key
+			parser.lines[parser.index] = yamlLine{number: line.number, indent: line.indent + len(line.content) - len(rest), content: rest}
+			item, err := parser.parseBlock(parser.lines[parser.index].indent, path)
+			if err != nil {
+				return nil, err
+			}
+			node.Items = append(node.Items, item)
+			continue
+		}
+		item, err := parseYamlFlow(rest, line.number, path)
+		if err != nil {
+			return nil, err
+		}
+		node.Items = append(node.Items, item)
+		parser.index++
+	}
+	return node, nil
+}
+
+func (parser *yamlParser) parseMapping(indent int, path string) (*ConfigNode, error) {
+	node := NewConfigMap(parser.lines[parser.index].number)
+	for parser.index < len(parser.lines) {
+		line := parser.lines[parser.index]
+		if line.indent < indent {
+			break
+		}
+		if line.indent > indent {
+			return nil, fmt.Errorf("line %d : unexpected indentation", line.number)
+		}
+		if isYamlSequenceItem(line.content) {
+			return nil, configValueError(line.number, path, "sequence item in a mapping")
+		}
+		if strings.HasPrefix(line.content, "? ") {
+			return nil, configValueError(line.number, path, "yaml complex keys are not supported")
+		}
+		key, value, isPair := splitYamlPair(line.content)
+		if !isPair {
+			// the line may be a misplaced value, it is not printed
+			return nil, configValueError(line.number, path, "expected key: value")
+		}
+		key, err := parseYamlKey(key, line.number)
+		if err != nil {
+			return nil, err
+		}
+		parser.index++
+		var child *ConfigNode
+		if value == "" {
+			child, err = parser.parseNested(indent, line.number, true, configPath(path, key))
+		} else if strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">") {
+			err = configValueError(line.number, configPath(path, key), "yaml block scalars are not supported, use a quoted string")
+		} else {
+			child, err = parseYamlFlow(value, line.number, configPath(path, key))
+		}
+		if err != nil {
+			return nil, err
+		}
+		child.Line = line.number
+		err = node.set(key, child)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+// parseNested reads the block under a key or a dash, a mapping value may be a
+// sequence at the same indentation as its key
+func (parser *yamlParser) parseNested(indent int, number int, sameIndentSequence bool, path string) (*ConfigNode, error) {
+	if parser.index < len(parser.lines) {
+		next := parser.lines[parser.index]
+		if next.indent > indent || (sameIndentSequence && next.indent == indent && isYamlSequenceItem(next.content)) {
+			return parser.parseBlock(next.indent, path)
+		}
+	}
+	return &ConfigNode{Kind: CONFIG_NULL, Line: number}, nil
+}
+
+// splitYamlPair cuts key: value on the first colon followed by a space or
+// ending the line, outside of quotes
+func splitYamlPair(content string) (string, string, bool) {
+	var quote byte
+	for index := 0; index < len(content); index++ {
+		c := content[index]
+		if quote != 0 {
+			if c == '\\' && quote == '"' {
+				index++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		if (c == '"' || c == '\'') && index == 0 {
+			quote = c
+			continue
+		}
+		if c == '[' || c == '{' {
+			return "", "", false
+		}
+		if c == ':' && (index+1 == len(content) || content[index+1] == ' ') {
+			return strings.TrimSpace(content[:index]), strings.TrimSpace(content[index+1:]), true
+		}
+	}
+	return "", "", false
+}
+
+func parseYamlKey(key string, number int) (string, error) {
+	if strings.HasPrefix(key, "\"") || strings.HasPrefix(key, "'") {
+		unquoted, rest, err := unquoteConfigString(key, true)
+		if err != nil || strings.TrimSpace(rest) != "" {
+			return "", fmt.Errorf("line %d : invalid key %s", number, key)
+		}
+		return unquoted, nil
+	}
+	if key == "" {
+		return "", fmt.Errorf("line %d : empty key", number)
+	}
+	return key, nil
+}
+
+// parseYamlFlow reads a scalar or a single line [a, b] or {k: v} collection
+func parseYamlFlow(value string, number int, path string) (*ConfigNode, error) {
+	value = strings.TrimSpace(value)
+	if !strings.ContainsAny(value[:1], "\"'[{&*!") {
+		// outside of a flow collection a plain scalar can hold commas
+		return yamlPlainScalar(value, number, path, false)
+	}
+	node, rest, err := parseYamlFlowValue(strings.TrimSpace(value), number, path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(rest) != "" {
+		return nil, configValueError(number, path, "unexpected text after the value")
+	}
+	return node, nil
+}
+
+func parseYamlFlowValue(value string, number int, path string) (*ConfigNode, string, error) {
+	if value == "" {
+		return &ConfigNode{Kind: CONFIG_NULL, Line: number}, "", nil
+	}
+	switch value[0] {
+	case '&', '*', '!':
+		return nil, "", configValueError(number, path, "yaml anchors, aliases and tags are not supported")
+	case '"', '\'':
+		unquoted, rest, err := unquoteConfigString(value, true)
+		if err != nil {
+			return nil, "", configValueError(number, path, err.Error())
+		}
+		return NewConfigScalar(number, unquoted, true), rest, nil
+	case '[':
+		node := NewConfigList(number)
+		rest := strings.TrimSpace(value[1:])
+		for !strings.HasPrefix(rest, "]") {
+			item, after, err := parseYamlFlowValue(rest, number, path)
+			if err != nil {
+				return nil, "", err
+			}
+			node.Items = append(node.Items, item)
+			rest = strings.TrimSpace(after)
+			if strings.HasPrefix(rest, ",") {
+				rest = strings.TrimSpace(rest[1:])
+			} else if !strings.HasPrefix(rest, "]") {
+				return nil, "", configValueError(number, path, "unterminated flow sequence, multi line flow collections are not supported")
+			}
+		}
+		return node, rest[1:], nil
+	case '{':
+		node := NewConfigMap(number)
+		rest := strings.TrimSpace(value[1:])
+		for !strings.HasPrefix(rest, "}") {
+			colon := strings.Index(rest, ":")
+			if colon < 0 {
+				return nil, "", configValueError(number, path, "unterminated flow mapping, multi line flow collections are not supported")
+			}
+			key, err := parseYamlKey(strings.TrimSpace(rest[:colon]), number)
+			if err != nil {
+				return nil, "", err
+			}
+			item, after, err := parseYamlFlowValue(strings.TrimSpace(rest[colon+1:]), number, configPath(path, key))
+			if err != nil {
+				return nil, "", err
+			}
+			err = node.set(key, item)
+			if err != nil {
+				return nil, "", err
+			}
+			rest = strings.TrimSpace(after)
+			if strings.HasPrefix(rest, ",") {
+				rest = strings.TrimSpace(rest[1:])
+			} else if !strings.HasPrefix(rest, "}") {
+				return nil, "", configValueError(number, path, "unterminated flow mapping, multi line flow collections are not supported")
+			}
+		}
+		return node, rest[1:], nil
+	}
+	// a plain scalar ends the line, or the item inside a flow collection
+	end := strings.IndexAny(value, ",]}")
+	if end < 0 {
+		end = len(value)
+	}
+	plain := strings.TrimSpace(value[:end])
+	if plain == "" {
+		return &ConfigNode{Kind: CONFIG_NULL, Line: number}, value[end:], nil
+	}
+	node, err := yamlPlainScalar(plain, number, path, true)
+	return node, value[end:], err
+}
+
+// yamlPlainScalar reads an unquoted value with the yaml 1.2 core schema, yes
+// and no are strings. A value yaml would reject, ex a: b: c, is an error
+// rather than a string so a typo is not taken for a password
+func yamlPlainScalar(plain string, number int, path string, inFlow bool) (*ConfigNode, error) {
+	if strings.Contains(plain, ": ") || strings.HasSuffix(plain, ":") {
+		return nil, configValueError(number, path, "a plain value can not hold ': ', quote it")
+	}
+	if strings.ContainsAny(plain[:1], "@`%|>,]}") || (inFlow && strings.ContainsAny(plain, "[{")) {
+		return nil, configValueError(number, path, "a plain value can not start with a yaml indicator, quote it")
+	}
+	switch plain {
+	case "~", "null", "Null", "NULL":
+		return &ConfigNode{Kind: CONFIG_NULL, Line: number}, nil
+	case "True", "TRUE":
+		plain = "true"
+	case "False", "FALSE":
+		plain = "false"
+	}
+	return NewConfigScalar(number, plain, false), nil
+}
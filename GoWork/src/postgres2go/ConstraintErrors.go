@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	SQLSTATE_NOT_NULL_VIOLATION    = "23502"
+	SQLSTATE_FOREIGN_KEY_VIOLATION = "23503"
+	SQLSTATE_UNIQUE_VIOLATION      = "23505"
+	SQLSTATE_CHECK_VIOLATION       = "23514"
+)
+
+// TableError is a sentinel error of a table, the violation of Constraint or,
+// without a Constraint, of any constraint of class Code
+type TableError struct {
+	Name       string
+	Message    string
+	Constraint string
+	Code       string
+}
+
+// tableErrors returns the errors of table : one per unique and foreign key,
+// then one per violation class for the constraints without their own
+func tableErrors(layout *OutputLayout, table *Table) []*TableError {
+	names := layout.naming.tableNames(table)
+	result := make([]*TableError, 0, 0)
+	for _, key := range fakeKeys(layout, table) {
+		columnNames := make([]string, 0, len(key.Columns))
+		for _, column := range key.Columns {
+			columnNames = append(columnNames, column.Name)
+		}
+		result = append(result, &TableError{
+			Name:       "Err" + names.Entity + strings.Join(key.Fields, "") + "Taken",
+			Message:    table.Name + " " + strings.Join(columnNames, ", ") + " already taken",
+			Constraint: key.Constraint,
+			Code:       SQLSTATE_UNIQUE_VIOLATION})
+	}
+	for _, foreignKey := range table.foreignKeys {
+		if foreignKey.ColumnName == "" {
+			continue
+		}
+		// org_id --> Org, two keys to the same table keep distinct names
+		reference := strings.TrimSuffix(foreignKey.ColumnName, "_id")
+		result = append(result, &TableError{
+			Name:       "Err" + names.Entity + layout.naming.goName(reference) + "FKViolation",
+			Message:    table.Name + " " + foreignKey.ColumnName + " references no " + foreignKey.RefTable,
+			Constraint: foreignKey.Name,
+			Code:       SQLSTATE_FOREIGN_KEY_VIOLATION})
+	}
+	result = append(result,
+		&TableError{Name: "Err" + names.Entity + "UniqueViolation", Message: table.Name + " unique violation", Code: SQLSTATE_UNIQUE_VIOLATION},
+		&TableError{Name: "Err" + names.Entity + "FKViolation", Message: table.Name + " foreign key violation", Code: SQLSTATE_FOREIGN_KEY_VIOLATION},
+		&TableError{Name: "Err" + names.Entity + "NotNullViolation", Message: table.Name + " not null violation", Code: SQLSTATE_NOT_NULL_VIOLATION},
+		&TableError{Name: "Err" + names.Entity + "CheckViolation", Message: table.Name + " check violation", Code: SQLSTATE_CHECK_VIOLATION})
+
+	errorNames := make([]string, 0, len(result))
+	for _, tableError := range result {
+		errorNames = append(errorNames, tableError.Name)
+	}
+	for i, unique := range uniqueNames(errorNames, []string{notFoundErrorName(names)}) {
+		result[i].Name = unique
+	}
+	return result
+}
+
+func notFoundErrorName(names *TableNames) string {
+	return "Err" + names.Entity + "NotFound"
+}
+
+func mapErrorFuncName(names *TableNames) string {
+	return "map" + names.Entity + "Error"
+}
+
+// writeTableErrors writes the sentinel errors of table and the function
+// mapping a *pq.Error to them
+func writeTableErrors(writer io.Writer, layout *OutputLayout, table *Table) {
+	names := layout.naming.tableNames(table)
+	tableErrors := tableErrors(layout, table)
+	fmt.Fprintf(writer, "var (\n")
+	fmt.Fprintf(writer, "\t%s = errors.New(%q)\n", notFoundErrorName(names), table.Name+" not found")
+	for _, tableError := range tableErrors {
+		fmt.Fprintf(writer, "\t%s = errors.New(%q)\n", tableError.Name, tableError.Message)
+	}
+	fmt.Fprintf(writer, ")\n\n")
+
+	fmt.Fprintf(writer, "// %s returns a *ConstraintError for the constraint violations of %s\n", mapErrorFuncName(names), table.Name)
+	fmt.Fprintf(writer, "func %s(err error) error {\n", mapErrorFuncName(names))
+	fmt.Fprintf(writer, "\tvar pqErr *pq.Error\n")
+	fmt.Fprintf(writer, "\tif !errors.As(err, &pqErr) {\n")
+	fmt.Fprintf(writer, "\t\treturn err\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\tswitch {\n")
+	for _, tableError := range tableErrors {
+		if tableError.Constraint != "" {
+			fmt.Fprintf(writer, "\tcase pqErr.Code == %q && pqErr.Constraint == %q:\n", tableError.Code, tableError.Constraint)
+		} else {
+			fmt.Fprintf(writer, "\tcase pqErr.Code == %q:\n", tableError.Code)
+		}
+		fmt.Fprintf(writer, "\t\treturn &ConstraintError{Err: %s, Cause: pqErr}\n", tableError.Name)
+	}
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn err\n")
+	fmt.Fprintf(writer, "}\n\n")
+}
+
+// writeConstraintErrorType writes the error the map<Entity>Error functions return
+func writeConstraintErrorType(writer io.Writer) {
+	fmt.Fprintf(writer, "// ConstraintError is a constraint violation reported by postgres, errors.Is\n")
+	fmt.Fprintf(writer, "// matches Err, ex ErrUserEmailTaken, and errors.As the *pq.Error\n")
+	fmt.Fprintf(writer, "type ConstraintError struct {\n")
+	fmt.Fprintf(writer, "\tErr   error\n")
+	fmt.Fprintf(writer, "\tCause *pq.Error\n")
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "func (err *ConstraintError) Error() string {\n")
+	fmt.Fprintf(writer, "\treturn err.Err.Error() + \" : \" + err.Cause.Message\n")
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "func (err *ConstraintError) Is(target error) bool {\n")
+	fmt.Fprintf(writer, "\treturn target == err.Err\n")
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "func (err *ConstraintError) Unwrap() error {\n")
+	fmt.Fprintf(writer, "\treturn err.Cause\n")
+	fmt.Fprintf(writer, "}\n\n")
+}
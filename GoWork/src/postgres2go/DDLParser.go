@@ -0,0 +1,1470 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Reads CREATE TABLE, ALTER TABLE, CREATE TYPE ... AS ENUM, CREATE INDEX and
+// COMMENT ON statements from migration files into the model descTable builds
+// from the catalog. Other statements are skipped.
+
+const (
+	SQL_WORD       = iota // keyword or unquoted identifier, lower cased
+	SQL_IDENTIFIER        // "quoted identifier"
+	SQL_STRING            // 'string', E'string' or $$string$$
+	SQL_NUMBER
+	SQL_SYMBOL
+)
+
+type sqlToken struct {
+	kind  int
+	value string
+	line  int
+}
+
+type ddlForeignKey struct {
+	name       string
+	columns    []string
+	refTable   string
+	refColumns []string
+	onUpdate   string
+	onDelete   string
+}
+
+type ddlUnique struct {
+	name    string
+	columns []string
+}
+
+type ddlTable struct {
+	table          *Table
+	primaryKeyName string
+	primaryKey     []string
+	uniques        []*ddlUnique
+	foreignKeys    []*ddlForeignKey
+	indexes        []*Index
+}
+
+type DDLParser struct {
+	fileName   string
+	tokens     []sqlToken
+	position   int
+	tables     map[string]*ddlTable
+	enums      map[string]*Enum
+	logWriter  *bufio.Writer
+	statements int
+}
+
+func NewDDLParser(logWriter_ *bufio.Writer) *DDLParser {
+	return &DDLParser{tables: make(map[string]*ddlTable), enums: make(map[string]*Enum), logWriter: logWriter_}
+}
+
+// parseDDLDirectory reads every .sql file of directory in file name order, the usual migration order
+func parseDDLDirectory(logWriter *bufio.Writer, directory string) (*Schema, error) {
+	fileNames, err := filepath.Glob(filepath.Join(directory, "*.sql"))
+	if err != nil {
+		return nil, err
+	}
+	if len(fileNames) == 0 {
+		return nil, fmt.Errorf("no .sql file in %s", directory)
+	}
+	sort.Strings(fileNames)
+	parser := NewDDLParser(logWriter)
+	for _, fileName := range fileNames {
+		content, err := ioutil.ReadFile(fileName)
+		if err != nil {
+			return nil, err
+		}
+		err = parser.parse(fileName, string(content))
+		if err != nil {
+			return nil, err
+		}
+	}
+	fmt.Fprintf(logWriter, "ddl files::%d statements::%d\n", len(fileNames), parser.statements)
+	return parser.schema(filepath.Base(directory)), nil
+}
+
+func (parser *DDLParser) parse(fileName string, content string) error {
+	tokens, err := tokenizeSQL(content)
+	if err != nil {
+		return fmt.Errorf("%s:%s", fileName, err.Error())
+	}
+	parser.fileName = fileName
+	start := 0
+	for index := 0; index <= len(tokens); index++ {
+		if index < len(tokens) && !(tokens[index].kind == SQL_SYMBOL && tokens[index].value == ";") {
+			continue
+		}
+		if index > start {
+			parser.tokens = tokens[start:index]
+			parser.position = 0
+			parser.statements++
+			err = parser.parseStatement()
+			if err != nil {
+				return err
+			}
+		}
+		start = index + 1
+	}
+	return nil
+}
+
+func tokenizeSQL(content string) ([]sqlToken, error) {
+	tokens := make([]sqlToken, 0, 0)
+	runes := []rune(content)
+	line := 1
+	index := 0
+	for index < len(runes) {
+		r := runes[index]
+		switch {
+		case r == '\n':
+			line++
+			index++
+		case unicode.IsSpace(r):
+			index++
+		case r == '-' && index+1 < len(runes) && runes[index+1] == '-':
+			for index < len(runes) && runes[index] != '\n' {
+				index++
+			}
+		case r == '/' && index+1 < len(runes) && runes[index+1] == '*':
+			depth := 0
+			startLine := line
+			for index < len(runes) {
+				if runes[index] == '/' && index+1 < len(runes) && runes[index+1] == '*' {
+					depth++
+					index += 2
+				} else if runes[index] == '*' && index+1 < len(runes) && runes[index+1] == '/' {
+					depth--
+					index += 2
+					if depth == 0 {
+						break
+					}
+				} else {
+					if runes[index] == '\n' {
+						line++
+					}
+					index++
+				}
+			}
+			if depth != 0 {
+				return nil, fmt.Errorf("%d: unterminated comment", startLine)
+			}
+		case r == '\'' || ((r == 'E' || r == 'e') && index+1 < len(runes) && runes[index+1] == '\''):
+			escapes := r != '\''
+			if escapes {
+				index++
+			}
+			startLine := line
+			var value strings.Builder
+			index++
+			closed := false
+			for index < len(runes) {
+				c := runes[index]
+				if c == '\n' {
+					line++
+				}
+				if escapes && c == '\\' && index+1 < len(runes) {
+					if runes[index+1] == '\n' {
+						line++
+					}
+					next, err := writeStringEscape(&value, runes, index+1)
+					if err != nil {
+						return nil, fmt.Errorf("%d: %s", line, err.Error())
+					}
+					index = next
+					continue
+				}
+				if c == '\'' {
+					if index+1 < len(runes) && runes[index+1] == '\'' {
+						value.WriteRune('\'')
+						index += 2
+						continue
+					}
+					index++
+					closed = true
+					break
+				}
+				value.WriteRune(c)
+				index++
+			}
+			if !closed {
+				return nil, fmt.Errorf("%d: unterminated string", startLine)
+			}
+			tokens = append(tokens, sqlToken{SQL_STRING, value.String(), startLine})
+		case r == '"':
+			startLine := line
+			var value strings.Builder
+			index++
+			closed := false
+			for index < len(runes) {
+				if runes[index] == '"' {
+					if index+1 < len(runes) && runes[index+1] == '"' {
+						value.WriteRune('"')
+						index += 2
+						continue
+					}
+					index++
+					closed = true
+					break
+				}
+				value.WriteRune(runes[index])
+				index++
+			}
+			if !closed {
+				return nil, fmt.Errorf("%d: unterminated quoted identifier", startLine)
+			}
+			tokens = append(tokens, sqlToken{SQL_IDENTIFIER, value.String(), startLine})
+		case r == '$' && index+1 < len(runes) && (runes[index+1] == '$' || unicode.IsLetter(runes[index+1]) || runes[index+1] == '_'):
+			// dollar quoted string $tag$...$tag$, a positional $1 is a symbol
+			end := index + 1
+			for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) || runes[end] == '_') {
+				end++
+			}
+			if end >= len(runes) || runes[end] != '$' {
+				tokens = append(tokens, sqlToken{SQL_SYMBOL, "$", line})
+				index++
+				continue
+			}
+			tag := string(runes[index : end+1])
+			body := string(runes[end+1:])
+			closing := strings.Index(body, tag)
+			if closing < 0 {
+				return nil, fmt.Errorf("%d: unterminated %s string", line, tag)
+			}
+			value := body[:closing]
+			tokens = append(tokens, sqlToken{SQL_STRING, value, line})
+			line += strings.Count(value, "\n")
+			index = end + 1 + len([]rune(value)) + len([]rune(tag))
+		case unicode.IsLetter(r) || r == '_':
+			start := index
+			for index < len(runes) && (unicode.IsLetter(runes[index]) || unicode.IsDigit(runes[index]) || runes[index] == '_' || runes[index] == '$') {
+				index++
+			}
+			tokens = append(tokens, sqlToken{SQL_WORD, strings.ToLower(string(runes[start:index])), line})
+		case unicode.IsDigit(r):
+			start := index
+			for index < len(runes) && (unicode.IsDigit(runes[index]) || runes[index] == '.') {
+				index++
+			}
+			tokens = append(tokens, sqlToken{SQL_NUMBER, string(runes[start:index]), line})
+		default:
+			tokens = append(tokens, sqlToken{SQL_SYMBOL, string(r), line})
+			index++
+		}
+	}
+	return tokens, nil
+}
+
+// writeStringEscape decodes the backslash escape of an E'string' starting at
+// runes[index], the rune after the backslash, and returns the index following it
+func writeStringEscape(value *strings.Builder, runes []rune, index int) (int, error) {
+	c := runes[index]
+	switch c {
+	case 'b':
+		value.WriteByte('\b')
+	case 'f':
+		value.WriteByte('\f')
+	case 'n':
+		value.WriteByte('\n')
+	case 'r':
+		value.WriteByte('\r')
+	case 't':
+		value.WriteByte('\t')
+	case '0', '1', '2', '3', '4', '5', '6', '7':
+		// \o, \oo or \ooo, a byte
+		code, end := scanStringEscapeDigits(runes, index, 3, 8)
+		value.WriteByte(byte(code))
+		return end, nil
+	case 'x':
+		// \xh or \xhh, a byte
+		code, end := scanStringEscapeDigits(runes, index+1, 2, 16)
+		if end == index+1 {
+			return index, fmt.Errorf("invalid escape \\x without hexadecimal digit")
+		}
+		value.WriteByte(byte(code))
+		return end, nil
+	case 'u', 'U':
+		// \uxxxx or \Uxxxxxxxx, a unicode code point
+		size := 4
+		if c == 'U' {
+			size = 8
+		}
+		code, end := scanStringEscapeDigits(runes, index+1, size, 16)
+		if end-index-1 != size || !utf8.ValidRune(rune(code)) {
+			return index, fmt.Errorf("invalid unicode escape \\%c", c)
+		}
+		value.WriteRune(rune(code))
+		return end, nil
+	default:
+		// \\, \' and any other character stand for themselves
+		value.WriteRune(c)
+	}
+	return index + 1, nil
+}
+
+// scanStringEscapeDigits reads up to count digits of base from runes[index]
+func scanStringEscapeDigits(runes []rune, index int, count int, base int) (int, int) {
+	code := 0
+	end := index
+	for end < len(runes) && end-index < count {
+		digit := strings.IndexRune("0123456789abcdef", unicode.ToLower(runes[end]))
+		if digit < 0 || digit >= base {
+			break
+		}
+		code = code*base + digit
+		end++
+	}
+	return code, end
+}
+
+func (parser *DDLParser) errorf(format string, args ...interface{}) error {
+	line := 0
+	if parser.position < len(parser.tokens) {
+		line = parser.tokens[parser.position].line
+	} else if len(parser.tokens) > 0 {
+		line = parser.tokens[len(parser.tokens)-1].line
+	}
+	return fmt.Errorf("%s:%d: %s", parser.fileName, line, fmt.Sprintf(format, args...))
+}
+
+func (parser *DDLParser) atEnd() bool {
+	return parser.position >= len(parser.tokens)
+}
+
+func (parser *DDLParser) peek() sqlToken {
+	if parser.atEnd() {
+		return sqlToken{kind: SQL_SYMBOL, value: ""}
+	}
+	return parser.tokens[parser.position]
+}
+
+func (parser *DDLParser) isWord(words ...string) bool {
+	token := parser.peek()
+	if token.kind != SQL_WORD {
+		return false
+	}
+	for _, word := range words {
+		if token.value == word {
+			return true
+		}
+	}
+	return false
+}
+
+func (parser *DDLParser) isSymbol(symbol string) bool {
+	token := parser.peek()
+	return token.kind == SQL_SYMBOL && token.value == symbol
+}
+
+// acceptWords consumes the sequence of words when all of them are next
+func (parser *DDLParser) acceptWords(words ...string) bool {
+	for offset, word := range words {
+		index := parser.position + offset
+		if index >= len(parser.tokens) || parser.tokens[index].kind != SQL_WORD || parser.tokens[index].value != word {
+			return false
+		}
+	}
+	parser.position += len(words)
+	return true
+}
+
+func (parser *DDLParser) acceptSymbol(symbol string) bool {
+	if parser.isSymbol(symbol) {
+		parser.position++
+		return true
+	}
+	return false
+}
+
+func (parser *DDLParser) expectWords(words ...string) error {
+	if !parser.acceptWords(words...) {
+		return parser.errorf("expected %s near [%s]", strings.ToUpper(strings.Join(words, " ")), parser.peek().value)
+	}
+	return nil
+}
+
+func (parser *DDLParser) expectSymbol(symbol string) error {
+	if !parser.acceptSymbol(symbol) {
+		return parser.errorf("expected %s near [%s]", symbol, parser.peek().value)
+	}
+	return nil
+}
+
+func (parser *DDLParser) identifier() (string, error) {
+	token := parser.peek()
+	if token.kind != SQL_WORD && token.kind != SQL_IDENTIFIER {
+		return "", parser.errorf("expected an identifier near [%s]", token.value)
+	}
+	parser.position++
+	return token.value, nil
+}
+
+// qualifiedName returns the parts of schema.name or table.column
+func (parser *DDLParser) qualifiedName() ([]string, error) {
+	parts := make([]string, 0, 2)
+	for {
+		part, err := parser.identifier()
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, part)
+		if !parser.acceptSymbol(".") {
+			return parts, nil
+		}
+	}
+}
+
+// objectName drops the schema, tables are looked up by name like pg_table_is_visible does
+func (parser *DDLParser) objectName() (string, error) {
+	parts, err := parser.qualifiedName()
+	if err != nil {
+		return "", err
+	}
+	return parts[len(parts)-1], nil
+}
+
+func (parser *DDLParser) identifierList() ([]string, error) {
+	err := parser.expectSymbol("(")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, 0)
+	for {
+		name, err := parser.identifier()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, name)
+		if parser.acceptSymbol(")") {
+			return result, nil
+		}
+		err = parser.expectSymbol(",")
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// skipParenthesis consumes a balanced ( ... ) group and returns its tokens
+func (parser *DDLParser) skipParenthesis() ([]sqlToken, error) {
+	start := parser.position
+	err := parser.expectSymbol("(")
+	if err != nil {
+		return nil, err
+	}
+	depth := 1
+	for depth > 0 {
+		if parser.atEnd() {
+			return nil, parser.errorf("unbalanced parenthesis")
+		}
+		if parser.isSymbol("(") {
+			depth++
+		} else if parser.isSymbol(")") {
+			depth--
+		}
+		parser.position++
+	}
+	return parser.tokens[start+1 : parser.position-1], nil
+}
+
+// skipUntil consumes tokens, balanced parenthesis included, until one of words or a , or ) at depth 0
+func (parser *DDLParser) skipUntil(words ...string) error {
+	first := true
+	for !parser.atEnd() {
+		if parser.isSymbol(",") || parser.isSymbol(")") {
+			return nil
+		}
+		if !first && parser.isWord(words...) {
+			return nil
+		}
+		if parser.isSymbol("(") {
+			_, err := parser.skipParenthesis()
+			if err != nil {
+				return err
+			}
+		} else {
+			parser.position++
+		}
+		first = false
+	}
+	return nil
+}
+
+func (parser *DDLParser) skipStatement() {
+	parser.position = len(parser.tokens)
+}
+
+func (parser *DDLParser) logSkipped(what string) {
+	fmt.Fprintf(parser.logWriter, "%s:%d: skipped %s\n", parser.fileName, parser.peek().line, what)
+}
+
+func (parser *DDLParser) parseStatement() error {
+	switch {
+	case parser.acceptWords("create"):
+		parser.acceptWords("or", "replace")
+		if parser.acceptWords("unlogged") || parser.acceptWords("temporary") || parser.acceptWords("temp") {
+			if !parser.isWord("table") {
+				parser.skipStatement()
+				return nil
+			}
+		}
+		if parser.acceptWords("table") {
+			return parser.parseCreateTable()
+		}
+		if parser.acceptWords("type") {
+			return parser.parseCreateType()
+		}
+		if parser.acceptWords("unique", "index") {
+			return parser.parseCreateIndex(true)
+		}
+		if parser.acceptWords("index") {
+			return parser.parseCreateIndex(false)
+		}
+	case parser.acceptWords("alter", "table"):
+		return parser.parseAlterTable()
+	case parser.acceptWords("alter", "type"):
+		return parser.parseAlterType()
+	case parser.acceptWords("comment", "on"):
+		return parser.parseComment()
+	case parser.acceptWords("drop", "table"):
+		return parser.parseDropTable()
+	case parser.acceptWords("drop", "type"):
+		return parser.parseDropType()
+	}
+	parser.skipStatement()
+	return nil
+}
+
+func (parser *DDLParser) lookupTable(name string) (*ddlTable, error) {
+	table, exists := parser.tables[name]
+	if !exists {
+		return nil, parser.errorf("unknown table %s", name)
+	}
+	return table, nil
+}
+
+func (parser *DDLParser) parseCreateTable() error {
+	ifNotExists := parser.acceptWords("if", "not", "exists")
+	name, err := parser.objectName()
+	if err != nil {
+		return err
+	}
+	if parser.isWord("partition", "of") || parser.isWord("as") {
+		parser.logSkipped("CREATE TABLE " + name)
+		parser.skipStatement()
+		return nil
+	}
+	if _, exists := parser.tables[name]; exists {
+		if !ifNotExists {
+			return parser.errorf("table %s already exists", name)
+		}
+		// CREATE TABLE IF NOT EXISTS on an existing table does nothing
+		parser.skipStatement()
+		return nil
+	}
+	table := &ddlTable{table: NewTable(name, name), uniques: make([]*ddlUnique, 0, 0), foreignKeys: make([]*ddlForeignKey, 0, 0), indexes: make([]*Index, 0, 0)}
+	table.table.columns = make([]*Column, 0, 0)
+	err = parser.expectSymbol("(")
+	if err != nil {
+		return err
+	}
+	for !parser.acceptSymbol(")") {
+		if parser.isWord("constraint", "primary", "unique", "foreign", "check", "exclude") {
+			err = parser.parseTableConstraint(table)
+		} else if parser.acceptWords("like") {
+			err = parser.skipUntil()
+		} else {
+			err = parser.parseColumn(table)
+		}
+		if err != nil {
+			return err
+		}
+		if !parser.acceptSymbol(",") && !parser.isSymbol(")") {
+			return parser.errorf("expected , or ) near [%s]", parser.peek().value)
+		}
+	}
+	parser.tables[name] = table
+	parser.skipStatement()
+	return nil
+}
+
+var columnConstraintWords = []string{"constraint", "not", "null", "default", "primary", "unique", "references", "check", "collate", "generated"}
+
+func (parser *DDLParser) parseColumn(table *ddlTable) error {
+	name, err := parser.identifier()
+	if err != nil {
+		return err
+	}
+	for _, column := range table.table.columns {
+		if column.Name == name {
+			return parser.errorf("duplicate column %s.%s", table.table.Name, name)
+		}
+	}
+	columnType, err := parser.parseType()
+	if err != nil {
+		return err
+	}
+	column := NewColumn(name, columnType, true)
+	table.table.columns = append(table.table.columns, column)
+	return parser.parseColumnConstraints(table, column)
+}
+
+// parseType returns the type spelled like pg_catalog.format_type does
+func (parser *DDLParser) parseType() (string, error) {
+	words := make([]string, 0, 0)
+	modifier := ""
+	isArray := false
+	for !parser.atEnd() && !parser.isSymbol(",") && !parser.isSymbol(")") && !parser.isWord(columnConstraintWords...) && !parser.isWord("using") {
+		token := parser.peek()
+		switch {
+		case token.kind == SQL_SYMBOL && token.value == "(":
+			inner, err := parser.skipParenthesis()
+			if err != nil {
+				return "", err
+			}
+			values := make([]string, 0, 0)
+			for _, innerToken := range inner {
+				if innerToken.kind != SQL_SYMBOL {
+					values = append(values, innerToken.value)
+				}
+			}
+			modifier = "(" + strings.Join(values, ",") + ")"
+		case token.kind == SQL_SYMBOL && token.value == "[":
+			parser.position++
+			for !parser.atEnd() && !parser.isSymbol("]") {
+				parser.position++
+			}
+			parser.acceptSymbol("]")
+			isArray = true
+		case token.kind == SQL_WORD && token.value == "array":
+			parser.position++
+			isArray = true
+		case token.kind == SQL_SYMBOL && token.value == ".":
+			// schema qualified type, keep the type name
+			parser.position++
+			words = words[:0]
+		case token.kind == SQL_WORD || token.kind == SQL_IDENTIFIER:
+			parser.position++
+			words = append(words, token.value)
+		default:
+			return "", parser.errorf("unexpected [%s] in column type", token.value)
+		}
+	}
+	if len(words) == 0 {
+		return "", parser.errorf("missing column type")
+	}
+	result := normalizeTypeName(strings.Join(words, " "), modifier)
+	if isArray {
+		result += "[]"
+	}
+	return result, nil
+}
+
+func normalizeTypeName(name string, modifier string) string {
+	switch name {
+	case "int", "int4", "integer", "serial", "serial4":
+		return "integer"
+	case "int8", "bigint", "bigserial", "serial8":
+		return "bigint"
+	case "int2", "smallint", "smallserial", "serial2":
+		return "smallint"
+	case "float8", "double precision":
+		return "double precision"
+	case "float4", "real":
+		return "real"
+	case "float":
+		return "double precision"
+	case "bool", "boolean":
+		return "boolean"
+	case "varchar", "character varying":
+		return "character varying" + modifier
+	case "char", "character", "bpchar":
+		if modifier == "" {
+			modifier = "(1)"
+		}
+		return "character" + modifier
+	case "decimal", "numeric":
+		return "numeric" + modifier
+	case "timestamp", "timestamp without time zone":
+		return "timestamp" + modifier + " without time zone"
+	case "timestamptz", "timestamp with time zone":
+		return "timestamp" + modifier + " with time zone"
+	case "time", "time without time zone":
+		return "time" + modifier + " without time zone"
+	case "timetz", "time with time zone":
+		return "time" + modifier + " with time zone"
+	case "varbit", "bit varying":
+		return "bit varying" + modifier
+	}
+	return name + modifier
+}
+
+func (parser *DDLParser) parseColumnConstraints(table *ddlTable, column *Column) error {
+	constraintName := ""
+	for !parser.atEnd() && !parser.isSymbol(",") && !parser.isSymbol(")") {
+		switch {
+		case parser.acceptWords("constraint"):
+			name, err := parser.identifier()
+			if err != nil {
+				return err
+			}
+			constraintName = name
+			continue
+		case parser.acceptWords("not", "null"):
+			column.IsNullable = false
+		case parser.acceptWords("null"):
+			column.IsNullable = true
+		case parser.acceptWords("not", "deferrable"), parser.acceptWords("deferrable"):
+		case parser.acceptWords("initially", "deferred"), parser.acceptWords("initially", "immediate"):
+		case parser.acceptWords("primary", "key"):
+			table.primaryKeyName = constraintName
+			table.primaryKey = []string{column.Name}
+			column.IsNullable = false
+		case parser.acceptWords("unique"):
+			parser.acceptWords("nulls", "not", "distinct")
+			parser.acceptWords("nulls", "distinct")
+			table.uniques = append(table.uniques, &ddlUnique{name: constraintName, columns: []string{column.Name}})
+		case parser.acceptWords("references"):
+			foreignKey, err := parser.parseReferences(constraintName, []string{column.Name})
+			if err != nil {
+				return err
+			}
+			table.foreignKeys = append(table.foreignKeys, foreignKey)
+		case parser.acceptWords("default"), parser.acceptWords("generated"), parser.acceptWords("collate"):
+			err := parser.skipUntil(columnConstraintWords...)
+			if err != nil {
+				return err
+			}
+		case parser.acceptWords("check"):
+			_, err := parser.skipParenthesis()
+			if err != nil {
+				return err
+			}
+			parser.acceptWords("no", "inherit")
+		default:
+			return parser.errorf("unexpected [%s] in column %s", parser.peek().value, column.Name)
+		}
+		constraintName = ""
+	}
+	return nil
+}
+
+func (parser *DDLParser) parseReferences(name string, columns []string) (*ddlForeignKey, error) {
+	refTable, err := parser.objectName()
+	if err != nil {
+		return nil, err
+	}
+	foreignKey := &ddlForeignKey{name: name, columns: columns, refTable: refTable}
+	if parser.isSymbol("(") {
+		foreignKey.refColumns, err = parser.identifierList()
+		if err != nil {
+			return nil, err
+		}
+	}
+	for {
+		switch {
+		case parser.acceptWords("match", "full"), parser.acceptWords("match", "partial"), parser.acceptWords("match", "simple"):
+		case parser.acceptWords("on", "delete"):
+			foreignKey.onDelete = parser.referentialAction()
+		case parser.acceptWords("on", "update"):
+			foreignKey.onUpdate = parser.referentialAction()
+		case parser.acceptWords("not", "deferrable"), parser.acceptWords("deferrable"):
+		case parser.acceptWords("initially", "deferred"), parser.acceptWords("initially", "immediate"):
+		case parser.acceptWords("not", "valid"):
+		default:
+			return foreignKey, nil
+		}
+	}
+}
+
+func (parser *DDLParser) referentialAction() string {
+	for _, action := range [][]string{{"cascade"}, {"restrict"}, {"no", "action"}, {"set", "null"}, {"set", "default"}} {
+		if parser.acceptWords(action...) {
+			if len(action) == 2 && parser.isSymbol("(") {
+				// SET NULL (column, ...)
+				parser.skipParenthesis()
+			}
+			return strings.ToUpper(strings.Join(action, " "))
+		}
+	}
+	return ""
+}
+
+func (parser *DDLParser) parseTableConstraint(table *ddlTable) error {
+	name := ""
+	if parser.acceptWords("constraint") {
+		identifier, err := parser.identifier()
+		if err != nil {
+			return err
+		}
+		name = identifier
+	}
+	switch {
+	case parser.acceptWords("primary", "key"):
+		columns, err := parser.identifierList()
+		if err != nil {
+			return err
+		}
+		table.primaryKeyName = name
+		table.primaryKey = columns
+		for _, column := range table.table.columns {
+			for _, columnName := range columns {
+				if column.Name == columnName {
+					column.IsNullable = false
+				}
+			}
+		}
+	case parser.acceptWords("unique"):
+		parser.acceptWords("nulls", "not", "distinct")
+		parser.acceptWords("nulls", "distinct")
+		columns, err := parser.identifierList()
+		if err != nil {
+			return err
+		}
+		table.uniques = append(table.uniques, &ddlUnique{name: name, columns: columns})
+	case parser.acceptWords("foreign", "key"):
+		columns, err := parser.identifierList()
+		if err != nil {
+			return err
+		}
+		err = parser.expectWords("references")
+		if err != nil {
+			return err
+		}
+		foreignKey, err := parser.parseReferences(name, columns)
+		if err != nil {
+			return err
+		}
+		table.foreignKeys = append(table.foreignKeys, foreignKey)
+	case parser.acceptWords("check"), parser.acceptWords("exclude"):
+		return parser.skipUntil()
+	default:
+		return parser.errorf("unexpected [%s] in table constraint", parser.peek().value)
+	}
+	// USING INDEX TABLESPACE, DEFERRABLE ...
+	return parser.skipUntil()
+}
+
+func (parser *DDLParser) parseAlterTable() error {
+	ifExists := parser.acceptWords("if", "exists")
+	parser.acceptWords("only")
+	name, err := parser.objectName()
+	if err != nil {
+		return err
+	}
+	parser.acceptSymbol("*")
+	if _, exists := parser.tables[name]; !exists {
+		// a sequence, a view or a skipped table, ALTER TABLE takes them all
+		if !ifExists {
+			parser.logSkipped("ALTER TABLE " + name)
+		}
+		parser.skipStatement()
+		return nil
+	}
+	table, err := parser.lookupTable(name)
+	if err != nil {
+		return err
+	}
+	if parser.acceptWords("rename", "to") {
+		newName, err := parser.identifier()
+		if err != nil {
+			return err
+		}
+		delete(parser.tables, name)
+		table.table.Name = newName
+		table.table.Oid = newName
+		parser.tables[newName] = table
+		return nil
+	}
+	for {
+		err = parser.parseAlterTableAction(table)
+		if err != nil {
+			return err
+		}
+		if !parser.acceptSymbol(",") {
+			break
+		}
+	}
+	if !parser.atEnd() {
+		return parser.errorf("unexpected [%s] in ALTER TABLE %s", parser.peek().value, name)
+	}
+	return nil
+}
+
+func (parser *DDLParser) findColumn(table *ddlTable, name string) (*Column, int, error) {
+	for index, column := range table.table.columns {
+		if column.Name == name {
+			return column, index, nil
+		}
+	}
+	return nil, -1, parser.errorf("unknown column %s.%s", table.table.Name, name)
+}
+
+func (parser *DDLParser) parseAlterTableAction(table *ddlTable) error {
+	switch {
+	case parser.isWord("add") && parser.position+1 < len(parser.tokens) && parser.tokens[parser.position+1].kind == SQL_WORD && (parser.tokens[parser.position+1].value == "constraint" || parser.tokens[parser.position+1].value == "primary" || parser.tokens[parser.position+1].value == "unique" || parser.tokens[parser.position+1].value == "foreign" || parser.tokens[parser.position+1].value == "check" || parser.tokens[parser.position+1].value == "exclude"):
+		parser.position++
+		return parser.parseTableConstraint(table)
+	case parser.acceptWords("add"):
+		parser.acceptWords("column")
+		if parser.acceptWords("if", "not", "exists") {
+			name := parser.peek().value
+			if _, _, err := parser.findColumn(table, name); err == nil {
+				return parser.skipUntil()
+			}
+		}
+		return parser.parseColumn(table)
+	case parser.acceptWords("drop", "constraint"):
+		parser.acceptWords("if", "exists")
+		name, err := parser.identifier()
+		if err != nil {
+			return err
+		}
+		table.dropConstraint(name)
+		parser.acceptWords("cascade")
+		parser.acceptWords("restrict")
+		return nil
+	case parser.acceptWords("drop"):
+		parser.acceptWords("column")
+		ifExists := parser.acceptWords("if", "exists")
+		name, err := parser.identifier()
+		if err != nil {
+			return err
+		}
+		_, index, err := parser.findColumn(table, name)
+		if err != nil {
+			if ifExists {
+				return nil
+			}
+			return err
+		}
+		table.table.columns = append(table.table.columns[:index], table.table.columns[index+1:]...)
+		table.dropColumn(name)
+		parser.acceptWords("cascade")
+		parser.acceptWords("restrict")
+		return nil
+	case parser.acceptWords("rename", "constraint"):
+		oldName, err := parser.identifier()
+		if err != nil {
+			return err
+		}
+		err = parser.expectWords("to")
+		if err != nil {
+			return err
+		}
+		newName, err := parser.identifier()
+		if err != nil {
+			return err
+		}
+		table.renameConstraint(oldName, newName)
+		return nil
+	case parser.acceptWords("rename"):
+		parser.acceptWords("column")
+		oldName, err := parser.identifier()
+		if err != nil {
+			return err
+		}
+		err = parser.expectWords("to")
+		if err != nil {
+			return err
+		}
+		newName, err := parser.identifier()
+		if err != nil {
+			return err
+		}
+		column, _, err := parser.findColumn(table, oldName)
+		if err != nil {
+			return err
+		}
+		column.Name = newName
+		table.renameColumn(oldName, newName)
+		return nil
+	case parser.acceptWords("alter"):
+		parser.acceptWords("column")
+		name, err := parser.identifier()
+		if err != nil {
+			return err
+		}
+		column, _, err := parser.findColumn(table, name)
+		if err != nil {
+			return err
+		}
+		switch {
+		case parser.acceptWords("set", "not", "null"):
+			column.IsNullable = false
+		case parser.acceptWords("drop", "not", "null"):
+			column.IsNullable = true
+		case parser.acceptWords("set", "data", "type"), parser.acceptWords("type"):
+			columnType, err := parser.parseType()
+			if err != nil {
+				return err
+			}
+			column.Type = columnType
+			return parser.skipUntil()
+		default:
+			// SET DEFAULT, DROP DEFAULT, SET STATISTICS ... do not change the model
+			return parser.skipUntil()
+		}
+		return nil
+	}
+	parser.logSkipped("ALTER TABLE " + table.table.Name + " " + parser.peek().value)
+	return parser.skipUntil()
+}
+
+func (table *ddlTable) dropConstraint(name string) {
+	if table.primaryKeyName == name || (name == table.table.Name+"_pkey" && table.primaryKeyName == "") {
+		table.primaryKeyName = ""
+		table.primaryKey = nil
+	}
+	uniques := make([]*ddlUnique, 0, len(table.uniques))
+	for _, unique := range table.uniques {
+		if unique.name != name {
+			uniques = append(uniques, unique)
+		}
+	}
+	table.uniques = uniques
+	foreignKeys := make([]*ddlForeignKey, 0, len(table.foreignKeys))
+	for _, foreignKey := range table.foreignKeys {
+		if foreignKey.name != name {
+			foreignKeys = append(foreignKeys, foreignKey)
+		}
+	}
+	table.foreignKeys = foreignKeys
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func renameInList(values []string, oldName string, newName string) {
+	for index, v := range values {
+		if v == oldName {
+			values[index] = newName
+		}
+	}
+}
+
+// dropColumn removes the constraints and indexes using the column, like DROP COLUMN does
+func (table *ddlTable) dropColumn(name string) {
+	if containsString(table.primaryKey, name) {
+		table.primaryKeyName = ""
+		table.primaryKey = nil
+	}
+	uniques := make([]*ddlUnique, 0, len(table.uniques))
+	for _, unique := range table.uniques {
+		if !containsString(unique.columns, name) {
+			uniques = append(uniques, unique)
+		}
+	}
+	table.uniques = uniques
+	foreignKeys := make([]*ddlForeignKey, 0, len(table.foreignKeys))
+	for _, foreignKey := range table.foreignKeys {
+		if !containsString(foreignKey.columns, name) {
+			foreignKeys = append(foreignKeys, foreignKey)
+		}
+	}
+	table.foreignKeys = foreignKeys
+	indexes := make([]*Index, 0, len(table.indexes))
+	for _, index := range table.indexes {
+		if !containsString(index.Columns, name) {
+			indexes = append(indexes, index)
+		}
+	}
+	table.indexes = indexes
+}
+
+func (table *ddlTable) renameColumn(oldName string, newName string) {
+	renameInList(table.primaryKey, oldName, newName)
+	for _, unique := range table.uniques {
+		renameInList(unique.columns, oldName, newName)
+	}
+	for _, foreignKey := range table.foreignKeys {
+		renameInList(foreignKey.columns, oldName, newName)
+	}
+	for _, index := range table.indexes {
+		renameInList(index.Columns, oldName, newName)
+	}
+}
+
+func (table *ddlTable) renameConstraint(oldName string, newName string) {
+	if table.primaryKeyName == oldName {
+		table.primaryKeyName = newName
+	}
+	for _, unique := range table.uniques {
+		if unique.name == oldName {
+			unique.name = newName
+		}
+	}
+	for _, foreignKey := range table.foreignKeys {
+		if foreignKey.name == oldName {
+			foreignKey.name = newName
+		}
+	}
+}
+
+func (parser *DDLParser) parseCreateIndex(unique bool) error {
+	parser.acceptWords("concurrently")
+	parser.acceptWords("if", "not", "exists")
+	name := ""
+	if !parser.isWord("on") {
+		identifier, err := parser.objectName()
+		if err != nil {
+			return err
+		}
+		name = identifier
+	}
+	err := parser.expectWords("on")
+	if err != nil {
+		return err
+	}
+	parser.acceptWords("only")
+	tableName, err := parser.objectName()
+	if err != nil {
+		return err
+	}
+	table, err := parser.lookupTable(tableName)
+	if err != nil {
+		return err
+	}
+	method := "btree"
+	if parser.acceptWords("using") {
+		method, err = parser.identifier()
+		if err != nil {
+			return err
+		}
+	}
+	start := parser.position
+	elements, err := parser.skipParenthesis()
+	if err != nil {
+		return err
+	}
+	// a column element is an identifier maybe followed by COLLATE, an operator
+	// class, ASC, DESC or NULLS, any other element is an expression
+	columns := make([]string, 0, 0)
+	nameParts := make([]string, 0, 0)
+	depth := 0
+	element := make([]sqlToken, 0, 0)
+	for index := 0; index <= len(elements); index++ {
+		if index == len(elements) || (depth == 0 && elements[index].kind == SQL_SYMBOL && elements[index].value == ",") {
+			if len(element) > 0 && (element[0].kind == SQL_WORD || element[0].kind == SQL_IDENTIFIER) && (len(element) == 1 || !(element[1].kind == SQL_SYMBOL && (element[1].value == "(" || element[1].value == "."))) {
+				columns = append(columns, element[0].value)
+				nameParts = append(nameParts, element[0].value)
+			} else if len(element) > 0 && element[0].kind == SQL_WORD {
+				// lower(email) gives <table>_lower_idx like postgres does
+				nameParts = append(nameParts, element[0].value)
+			} else {
+				nameParts = append(nameParts, "expr")
+			}
+			element = element[:0]
+			continue
+		}
+		if elements[index].kind == SQL_SYMBOL && elements[index].value == "(" {
+			depth++
+		} else if elements[index].kind == SQL_SYMBOL && elements[index].value == ")" {
+			depth--
+		}
+		element = append(element, elements[index])
+	}
+	if name == "" {
+		name = tableName + "_" + strings.Join(nameParts, "_") + "_idx"
+	}
+	for _, index := range table.indexes {
+		if index.Name == name {
+			// CREATE INDEX IF NOT EXISTS on an existing index does nothing
+			parser.skipStatement()
+			return nil
+		}
+	}
+	definition := "CREATE INDEX "
+	if unique {
+		definition = "CREATE UNIQUE INDEX "
+	}
+	definition += name + " ON " + tableName + " USING " + method + " " + tokensText(parser.tokens[start:parser.position])
+	if parser.acceptWords("where") {
+		definition += " WHERE " + tokensText(parser.tokens[parser.position:])
+	}
+	table.indexes = append(table.indexes, NewIndex(name, definition, "", false, unique, columns))
+	parser.skipStatement()
+	return nil
+}
+
+// tokensText spells tokens back as sql
+func tokensText(tokens []sqlToken) string {
+	var buffer strings.Builder
+	for index, token := range tokens {
+		if index > 0 {
+			previous := tokens[index-1]
+			if !(token.kind == SQL_SYMBOL && (token.value == ")" || token.value == "," || token.value == ".")) && !(previous.kind == SQL_SYMBOL && (previous.value == "(" || previous.value == ".")) && !(token.kind == SQL_SYMBOL && token.value == "(" && previous.kind == SQL_WORD) {
+				buffer.WriteString(" ")
+			}
+		}
+		switch token.kind {
+		case SQL_STRING:
+			buffer.WriteString("'" + strings.Replace(token.value, "'", "''", -1) + "'")
+		case SQL_IDENTIFIER:
+			buffer.WriteString("\"" + strings.Replace(token.value, "\"", "\"\"", -1) + "\"")
+		default:
+			buffer.WriteString(token.value)
+		}
+	}
+	return buffer.String()
+}
+
+func (parser *DDLParser) parseCreateType() error {
+	name, err := parser.objectName()
+	if err != nil {
+		return err
+	}
+	if !parser.acceptWords("as", "enum") {
+		parser.logSkipped("CREATE TYPE " + name)
+		parser.skipStatement()
+		return nil
+	}
+	enum := NewEnum(name)
+	err = parser.expectSymbol("(")
+	if err != nil {
+		return err
+	}
+	for !parser.acceptSymbol(")") {
+		token := parser.peek()
+		if token.kind != SQL_STRING {
+			return parser.errorf("expected an enum label near [%s]", token.value)
+		}
+		parser.position++
+		enum.Values = append(enum.Values, token.value)
+		if !parser.acceptSymbol(",") && !parser.isSymbol(")") {
+			return parser.errorf("expected , or ) near [%s]", parser.peek().value)
+		}
+	}
+	parser.enums[name] = enum
+	return nil
+}
+
+func (parser *DDLParser) parseAlterType() error {
+	name, err := parser.objectName()
+	if err != nil {
+		return err
+	}
+	enum, exists := parser.enums[name]
+	if !exists || !parser.acceptWords("add", "value") {
+		parser.skipStatement()
+		return nil
+	}
+	ifNotExists := parser.acceptWords("if", "not", "exists")
+	token := parser.peek()
+	if token.kind != SQL_STRING {
+		return parser.errorf("expected an enum label near [%s]", token.value)
+	}
+	parser.position++
+	if containsString(enum.Values, token.value) {
+		if ifNotExists {
+			return nil
+		}
+		return parser.errorf("enum label %s already exists in %s", token.value, name)
+	}
+	position := len(enum.Values)
+	before := parser.acceptWords("before")
+	after := !before && parser.acceptWords("after")
+	if before || after {
+		neighbour := parser.peek()
+		parser.position++
+		for index, value := range enum.Values {
+			if value == neighbour.value {
+				position = index
+				if after {
+					position++
+				}
+			}
+		}
+	}
+	values := append(make([]string, 0, len(enum.Values)+1), enum.Values[:position]...)
+	values = append(values, token.value)
+	enum.Values = append(values, enum.Values[position:]...)
+	return nil
+}
+
+func (parser *DDLParser) parseComment() error {
+	var comment *string
+	var err error
+	var parts []string
+	switch {
+	case parser.acceptWords("table"):
+		parts, err = parser.qualifiedName()
+		if err != nil {
+			return err
+		}
+		table, err := parser.lookupTable(parts[len(parts)-1])
+		if err != nil {
+			return err
+		}
+		comment = &table.table.Comment
+	case parser.acceptWords("column"):
+		parts, err = parser.qualifiedName()
+		if err != nil {
+			return err
+		}
+		if len(parts) < 2 {
+			return parser.errorf("expected table.column in COMMENT ON COLUMN")
+		}
+		table, err := parser.lookupTable(parts[len(parts)-2])
+		if err != nil {
+			return err
+		}
+		column, _, err := parser.findColumn(table, parts[len(parts)-1])
+		if err != nil {
+			return err
+		}
+		comment = &column.Comment
+	default:
+		parser.skipStatement()
+		return nil
+	}
+	err = parser.expectWords("is")
+	if err != nil {
+		return err
+	}
+	if parser.acceptWords("null") {
+		*comment = ""
+		return nil
+	}
+	token := parser.peek()
+	if token.kind != SQL_STRING {
+		return parser.errorf("expected a string near [%s]", token.value)
+	}
+	parser.position++
+	*comment = token.value
+	return nil
+}
+
+func (parser *DDLParser) parseDropTable() error {
+	ifExists := parser.acceptWords("if", "exists")
+	for {
+		name, err := parser.objectName()
+		if err != nil {
+			return err
+		}
+		if _, exists := parser.tables[name]; !exists && !ifExists {
+			return parser.errorf("unknown table %s", name)
+		}
+		delete(parser.tables, name)
+		if !parser.acceptSymbol(",") {
+			break
+		}
+	}
+	parser.skipStatement()
+	return nil
+}
+
+func (parser *DDLParser) parseDropType() error {
+	parser.acceptWords("if", "exists")
+	for {
+		name, err := parser.objectName()
+		if err != nil {
+			return err
+		}
+		delete(parser.enums, name)
+		if !parser.acceptSymbol(",") {
+			break
+		}
+	}
+	parser.skipStatement()
+	return nil
+}
+
+// schema builds the tables with the constraint and index definitions pg_catalog would return
+func (parser *DDLParser) schema(database string) *Schema {
+	schema := NewSchema(database)
+	tableNames := make([]string, 0, len(parser.tables))
+	for name := range parser.tables {
+		tableNames = append(tableNames, name)
+	}
+	sort.Strings(tableNames)
+	for _, name := range tableNames {
+		schema.Tables = append(schema.Tables, parser.tables[name].build(parser.tables))
+	}
+	enumNames := make([]string, 0, len(parser.enums))
+	for name := range parser.enums {
+		enumNames = append(enumNames, name)
+	}
+	sort.Strings(enumNames)
+	for _, name := range enumNames {
+		schema.Enums = append(schema.Enums, parser.enums[name])
+	}
+	return schema
+}
+
+func (table *ddlTable) build(tables map[string]*ddlTable) *Table {
+	result := table.table
+	tableName := result.Name
+	indexes := make([]*Index, 0, 0)
+	if len(table.primaryKey) > 0 {
+		name := table.primaryKeyName
+		if name == "" {
+			name = tableName + "_pkey"
+		}
+		constraint := "PRIMARY KEY (" + strings.Join(table.primaryKey, ", ") + ")"
+		indexes = append(indexes, NewIndex(name, "CREATE UNIQUE INDEX "+name+" ON "+tableName+" USING btree ("+strings.Join(table.primaryKey, ", ")+")", constraint, true, true, table.primaryKey))
+	}
+	for _, unique := range table.uniques {
+		name := unique.name
+		if name == "" {
+			name = tableName + "_" + strings.Join(unique.columns, "_") + "_key"
+		}
+		constraint := "UNIQUE (" + strings.Join(unique.columns, ", ") + ")"
+		indexes = append(indexes, NewIndex(name, "CREATE UNIQUE INDEX "+name+" ON "+tableName+" USING btree ("+strings.Join(unique.columns, ", ")+")", constraint, false, true, unique.columns))
+	}
+	indexes = append(indexes, table.indexes...)
+	// same order as the catalog query of getIndexesList
+	sort.SliceStable(indexes, func(i, j int) bool {
+		if indexes[i].IsPrimary != indexes[j].IsPrimary {
+			return indexes[i].IsPrimary
+		}
+		if indexes[i].IsUnique != indexes[j].IsUnique {
+			return indexes[i].IsUnique
+		}
+		return indexes[i].Name < indexes[j].Name
+	})
+	result.indexes = indexes
+	if len(indexes) > 0 && indexes[0].Constraint != "" {
+		result.PrimaryKeyName = indexes[0].Name
+		result.PrimaryKeyConstraint = indexes[0].Constraint
+	}
+
+	result.foreignKeys = make([]*ForeignKey, 0, len(table.foreignKeys))
+	for _, foreignKey := range table.foreignKeys {
+		name := foreignKey.name
+		if name == "" {
+			name = tableName + "_" + strings.Join(foreignKey.columns, "_") + "_fkey"
+		}
+		refColumns := foreignKey.refColumns
+		if len(refColumns) == 0 {
+			if refTable, exists := tables[foreignKey.refTable]; exists {
+				refColumns = refTable.primaryKey
+			}
+		}
+		constraint := "FOREIGN KEY (" + strings.Join(foreignKey.columns, ", ") + ") REFERENCES " + foreignKey.refTable + "(" + strings.Join(refColumns, ", ") + ")"
+		if foreignKey.onUpdate != "" && foreignKey.onUpdate != "NO ACTION" {
+			constraint += " ON UPDATE " + foreignKey.onUpdate
+		}
+		if foreignKey.onDelete != "" && foreignKey.onDelete != "NO ACTION" {
+			constraint += " ON DELETE " + foreignKey.onDelete
+		}
+		result.foreignKeys = append(result.foreignKeys, NewForeignKey(name, constraint))
+	}
+	sort.SliceStable(result.foreignKeys, func(i, j int) bool {
+		return result.foreignKeys[i].Name < result.foreignKeys[j].Name
+	})
+	return result
+}
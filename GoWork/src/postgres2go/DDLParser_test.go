@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestTokenizeSQLStrings(t *testing.T) {
+	tests := []struct {
+		sql      string
+		kind     int
+		expected string
+	}{
+		{`'it''s'`, SQL_STRING, "it's"},
+		{`'back\slash'`, SQL_STRING, `back\slash`},
+		{`E'mail\naddress'`, SQL_STRING, "mail\naddress"},
+		{`e'tab\there\r\b\f'`, SQL_STRING, "tab\there\r\b\f"},
+		{`E'it\'s \\ ok'`, SQL_STRING, `it's \ ok`},
+		{`E'\x41\x4a\x4Bz'`, SQL_STRING, "AJKz"},
+		{`E'\101\0612\7'`, SQL_STRING, "A12\a"},
+		{`E'café \U0001F600'`, SQL_STRING, "café 😀"},
+		{`E'\q\''`, SQL_STRING, "q'"},
+		{`$$it's $1$$`, SQL_STRING, "it's $1"},
+		{`$body$a $$ b$body$`, SQL_STRING, "a $$ b"},
+		{`"Mixed ""Case"""`, SQL_IDENTIFIER, `Mixed "Case"`},
+		{`Mixed_Case`, SQL_WORD, "mixed_case"},
+	}
+	for _, test := range tests {
+		tokens, err := tokenizeSQL(test.sql)
+		if err != nil {
+			t.Errorf("%s : %s", test.sql, err)
+			continue
+		}
+		if len(tokens) != 1 || tokens[0].kind != test.kind || tokens[0].value != test.expected {
+			t.Errorf("%s : tokens %+v, expected %q", test.sql, tokens, test.expected)
+		}
+	}
+}
+
+func TestTokenizeSQLLines(t *testing.T) {
+	tokens, err := tokenizeSQL("a /* one\n/* nested */\n*/ 'x\ny' E'\\\n' $$\n\n$$ b -- c\nd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := make([]string, 0, 0)
+	for _, token := range tokens {
+		lines = append(lines, fmt.Sprintf("%s:%d", token.value, token.line))
+	}
+	if got := strings.Join(lines, " "); got != "a:1 x\ny:3 \n:4 \n\n:5 b:7 d:8" {
+		t.Errorf("token lines %q", got)
+	}
+}
+
+// describeDDL parses the files of sql and lists the model as the generators see it
+func describeDDL(files ...string) (string, error) {
+	parser := NewDDLParser(bufio.NewWriter(ioutil.Discard))
+	for i, content := range files {
+		err := parser.parse(fmt.Sprintf("%d.sql", i+1), content)
+		if err != nil {
+			return "", err
+		}
+	}
+	lines := make([]string, 0, 0)
+	schema := parser.schema("test")
+	for _, table := range schema.Tables {
+		lines = append(lines, fmt.Sprintf("table %s %q", table.Name, table.Comment))
+		for _, column := range table.columns {
+			lines = append(lines, fmt.Sprintf("  %s %s nullable=%t %q", column.Name, column.Type, column.IsNullable, column.Comment))
+		}
+		for _, index := range table.indexes {
+			lines = append(lines, fmt.Sprintf("  index %s %s", index.Name, index.Constraint))
+		}
+		for _, foreignKey := range table.foreignKeys {
+			lines = append(lines, fmt.Sprintf("  fk %s %s", foreignKey.Name, foreignKey.ForeignKeyConstraint))
+		}
+	}
+	for _, enum := range schema.Enums {
+		lines = append(lines, fmt.Sprintf("enum %s %s", enum.Name, strings.Join(enum.Values, ",")))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func TestParseDDL(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    []string
+		expected string
+	}{
+		{
+			"create table",
+			[]string{`CREATE TABLE IF NOT EXISTS public.users (
+				id bigserial PRIMARY KEY,
+				email varchar(255) NOT NULL UNIQUE,
+				"Nick Name" text,
+				score numeric(10, 2) DEFAULT 0
+			);`},
+			`table users ""
+  id bigint nullable=false ""
+  email character varying(255) nullable=false ""
+  Nick Name text nullable=true ""
+  score numeric(10,2) nullable=true ""
+  index users_pkey PRIMARY KEY (id)
+  index users_email_key UNIQUE (email)`,
+		},
+		{
+			"alter table add constraint",
+			[]string{`create table team (id integer not null);
+				create table player (id integer not null, team_id integer, code text);
+				alter table team add constraint team_pk primary key (id);
+				ALTER TABLE ONLY player
+					ADD CONSTRAINT player_pkey PRIMARY KEY (id),
+					ADD CONSTRAINT player_team_fk FOREIGN KEY (team_id) REFERENCES team ON DELETE CASCADE,
+					ADD UNIQUE (code),
+					ADD CONSTRAINT positive CHECK (id > 0);`},
+			`table player ""
+  id integer nullable=false ""
+  team_id integer nullable=true ""
+  code text nullable=true ""
+  index player_pkey PRIMARY KEY (id)
+  index player_code_key UNIQUE (code)
+  fk player_team_fk FOREIGN KEY (team_id) REFERENCES team(id) ON DELETE CASCADE
+table team ""
+  id integer nullable=false ""
+  index team_pk PRIMARY KEY (id)`,
+		},
+		{
+			"migrations in order",
+			[]string{
+				`create table item (id serial primary key, label text, old int);`,
+				`alter table item add column if not exists price int not null, drop column old, rename column label to title;
+				alter table item alter column title set not null;
+				alter table if exists missing add column x int;`,
+				`CREATE SEQUENCE public.item_id_seq START WITH 1;
+				ALTER TABLE public.item_id_seq OWNER TO app;
+				create table if not exists item (id serial primary key);`,
+			},
+			`table item ""
+  id integer nullable=false ""
+  title text nullable=false ""
+  price integer nullable=false ""
+  index item_pkey PRIMARY KEY (id)`,
+		},
+		{
+			"comment on",
+			[]string{`create table note (id int primary key, body text);
+				COMMENT ON TABLE note IS 'Notes';
+				comment on column public.note.body is E'first line\nsecond';
+				comment on column note.id is $$the 'id'$$;
+				comment on column note.id is null;
+				comment on index note_pkey is 'ignored';`},
+			`table note "Notes"
+  id integer nullable=false ""
+  body text nullable=true "first line\nsecond"
+  index note_pkey PRIMARY KEY (id)`,
+		},
+		{
+			"enums and skipped statements",
+			[]string{`create type mood as enum ('sad', 'ok');
+				alter type mood add value 'happy' after 'ok';
+				alter type mood add value if not exists 'ok';
+				create function f() returns trigger as $fn$ begin; return new; end $fn$ language plpgsql;
+				create table "Diary" (id int, mood mood);`},
+			`table Diary ""
+  id integer nullable=true ""
+  mood mood nullable=true ""
+enum mood sad,ok,happy`,
+		},
+	}
+	for _, test := range tests {
+		got, err := describeDDL(test.files...)
+		if err != nil {
+			t.Errorf("%s : %s", test.name, err)
+		} else if got != test.expected {
+			t.Errorf("%s :\n%s\nexpected\n%s", test.name, got, test.expected)
+		}
+	}
+}
+
+func TestParseDDLErrors(t *testing.T) {
+	tests := []struct {
+		sql      string
+		expected string
+	}{
+		{"create table t (id int", "1.sql:1: "},
+		{"select 1;\n'open", "1.sql:2: unterminated string"},
+		{"/* open\n", "1.sql:1: unterminated comment"},
+		{"create table \"t (id int);", "1.sql:1: unterminated quoted identifier"},
+		{"comment on table t is 'x' $$ open", "1.sql:1: unterminated $$ string"},
+		{"create table t (id int);\nselect E'\\u12';", "1.sql:2: invalid unicode escape \\u"},
+		{"create table t (id int);\nselect E'\\xz';", "1.sql:2: invalid escape \\x"},
+		{"create table t (id int);\ncreate table t (id int);", "1.sql:2: table t already exists"},
+		{"create table t (id int);\nalter table t drop column y;", "1.sql:2: unknown column t.y"},
+		{"create table t (id int);\ncomment on column t is 'x';", "1.sql:2: expected table.column in COMMENT ON COLUMN"},
+		{"create table t (id int);\nalter table t add constraint c bogus (id);", "1.sql:2: unexpected [bogus] in table constraint"},
+	}
+	for _, test := range tests {
+		_, err := describeDDL(test.sql)
+		if err == nil {
+			t.Errorf("%q : no error, expected %s", test.sql, test.expected)
+		} else if !strings.HasPrefix(err.Error(), test.expected) {
+			t.Errorf("%q : error %q, expected %s", test.sql, err.Error(), test.expected)
+		}
+	}
+}
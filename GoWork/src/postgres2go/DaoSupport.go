@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+)
+
+const DAO_SUPPORT_FILE_NAME = "DaoSupport"
+
+// generateDaoSupport writes the declarations the DAO of every table share :
+// the DBTX interface, satisfied by *sql.DB, *sql.Tx and *sql.Conn, the
+// transaction helper, ErrStaleEntity, ConstraintError, the placeholders of the
+// multi-row inserts and the rendering of the query builders
+func generateDaoSupport(layout *OutputLayout, sink OutputSink) error {
+	withTx := layout.funcName("withTx")
+	var buffer bytes.Buffer
+	writer := bufio.NewWriter(&buffer)
+	writeSupportHeader(writer, layout, KIND_DAO)
+	writeImports(writer, []string{"context", "database/sql", "errors", "strconv", "strings", PQ_IMPORT_PATH})
+
+	fmt.Fprintf(writer, "// DBTX runs the queries of the DAO functions, pass a *sql.DB or the *sql.Tx of %s\n", withTx)
+	fmt.Fprintf(writer, "type DBTX interface {\n")
+	fmt.Fprintf(writer, "\tQueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)\n")
+	fmt.Fprintf(writer, "\tQueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row\n")
+	fmt.Fprintf(writer, "\tExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "// %s runs fn in a transaction, committed when fn returns nil and rolled back\n", withTx)
+	fmt.Fprintf(writer, "// when fn returns an error or panics\n")
+	fmt.Fprintf(writer, "func %s(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {\n", withTx)
+	fmt.Fprintf(writer, "\ttx, err := db.BeginTx(ctx, nil)\n")
+	fmt.Fprintf(writer, "\tif err != nil {\n")
+	fmt.Fprintf(writer, "\t\treturn err\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\tdefer func() {\n")
+	fmt.Fprintf(writer, "\t\tif p := recover(); p != nil {\n")
+	fmt.Fprintf(writer, "\t\t\ttx.Rollback()\n")
+	fmt.Fprintf(writer, "\t\t\tpanic(p)\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t}()\n")
+	fmt.Fprintf(writer, "\terr = fn(tx)\n")
+	fmt.Fprintf(writer, "\tif err != nil {\n")
+	fmt.Fprintf(writer, "\t\ttx.Rollback()\n")
+	fmt.Fprintf(writer, "\t\treturn err\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn tx.Commit()\n")
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "// ErrStaleEntity is the error of the updates checking a version column, the row\n")
+	fmt.Fprintf(writer, "// changed or was deleted since the entity was read\n")
+	fmt.Fprintf(writer, "var ErrStaleEntity = errors.New(\"stale entity\")\n\n")
+	writeConstraintErrorType(writer)
+	writeValuesPlaceholders(writer)
+	writeQuerySupport(writer)
+	writeUserCodeRegion(writer, layout, USER_CODE_CODE)
+
+	writer.Flush()
+	return sink.writeFile(layout.fileName(KIND_DAO, DAO_SUPPORT_FILE_NAME), buffer.Bytes())
+}
@@ -0,0 +1,10 @@
+package main
+
+type Enum struct {
+	Name   string
+	Values []string
+}
+
+func NewEnum(name_ string) *Enum {
+	return &Enum{Name: name_, Values: make([]string, 0, 0)}
+}
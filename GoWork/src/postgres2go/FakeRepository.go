@@ -0,0 +1,546 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const FAKE_DATABASE_FILE_NAME = "FakeDatabase"
+
+// FakeKey is a primary key or unique constraint the fake repository checks,
+// a foreign key is a FakeKey of the referenced table
+type FakeKey struct {
+	Constraint string
+	Table      string
+	Columns    []*Column
+	Fields     []string
+}
+
+// fakeKeyName is the name of the key in the FakeDatabase, ex user_account(org_id,email)
+func fakeKeyName(table string, columns []string) string {
+	return table + "(" + strings.Join(columns, ",") + ")"
+}
+
+func (key *FakeKey) name() string {
+	columnNames := make([]string, 0, len(key.Columns))
+	for _, column := range key.Columns {
+		columnNames = append(columnNames, column.Name)
+	}
+	return fakeKeyName(key.Table, columnNames)
+}
+
+// isExpressionIndex tells whether the definition indexes an expression or a
+// subset of the rows, the fake can not check them
+func isExpressionIndex(index *Index) bool {
+	if strings.Contains(index.Definition, " WHERE ") {
+		return true
+	}
+	start := strings.Index(index.Definition, "(")
+	end := strings.LastIndex(index.Definition, ")")
+	return start < 0 || end < start || strings.Contains(index.Definition[start+1:end], "(")
+}
+
+// fakeKeys returns the primary and unique keys of table on mapped columns
+func fakeKeys(layout *OutputLayout, table *Table) []*FakeKey {
+	names := layout.naming.tableNames(table)
+	columns := table.goColumns()
+	result := make([]*FakeKey, 0, 0)
+	for _, index := range table.indexes {
+		if !index.IsUnique || len(index.Columns) == 0 || isExpressionIndex(index) {
+			continue
+		}
+		key := &FakeKey{Constraint: index.Name, Table: table.Name}
+		for _, columnName := range index.Columns {
+			for i, column := range columns {
+				if column.Name == columnName {
+					key.Columns = append(key.Columns, column)
+					key.Fields = append(key.Fields, names.Fields[i])
+				}
+			}
+		}
+		if len(key.Columns) == len(index.Columns) {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+// isNilable tells whether a value of goType can be nil, a NOT NULL column of
+// such a type is the only one the fake has to check
+func isNilable(goType string) bool {
+	return strings.HasPrefix(goType, "*") || strings.HasPrefix(goType, "[]") || strings.HasPrefix(goType, "map[") || goType == JSON_MAPPING.Type || goType == "interface{}" || isArrayType(goType)
+}
+
+// fakeValue returns the expression of the value of field in row and the
+// condition of a non null value, empty for a column that is never null
+func fakeValue(column *Column, field string) (string, string) {
+	goType := columnGoType(column)
+	if isNullWrapped(column) {
+		mapping, _ := columnTypeMapping(column)
+		return "row." + field + "." + mapping.NullField, "row." + field + ".Valid"
+	}
+	if strings.HasPrefix(goType, "*") {
+		return "*row." + field, "row." + field + " != nil"
+	}
+	if isNilable(goType) {
+		return "row." + field, "row." + field + " != nil"
+	}
+	return "row." + field, ""
+}
+
+// generateFakeDatabase writes the state the fake repositories share : the keys
+// of every table, so a foreign key sees the rows of the referenced table, and
+// the sequences
+func generateFakeDatabase(layout *OutputLayout, sink OutputSink) error {
+	var buffer bytes.Buffer
+	writer := bufio.NewWriter(&buffer)
+	writeSupportHeader(writer, layout, KIND_DAO)
+	writeImports(writer, []string{"fmt", "strings", "sync", PQ_IMPORT_PATH})
+
+	fmt.Fprintf(writer, "// FakeDatabase holds the keys and the sequences of the fake repositories\n")
+	fmt.Fprintf(writer, "// built on it, a violated constraint returns the *pq.Error postgres would\n")
+	fmt.Fprintf(writer, "type FakeDatabase struct {\n")
+	fmt.Fprintf(writer, "\tlock      sync.Mutex\n")
+	fmt.Fprintf(writer, "\tkeys      map[string]bool\n")
+	fmt.Fprintf(writer, "\tsequences map[string]int64\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "func NewFakeDatabase() *FakeDatabase {\n")
+	fmt.Fprintf(writer, "\treturn &FakeDatabase{keys: make(map[string]bool), sequences: make(map[string]int64)}\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "func fakeKeyValue(key string, values ...interface{}) string {\n")
+	fmt.Fprintf(writer, "\ttexts := make([]string, 0, len(values))\n")
+	fmt.Fprintf(writer, "\tfor _, value := range values {\n")
+	fmt.Fprintf(writer, "\t\ttexts = append(texts, fmt.Sprint(value))\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn key + \"=(\" + strings.Join(texts, \", \") + \")\"\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "func (db *FakeDatabase) hasKey(key string, values ...interface{}) bool {\n")
+	fmt.Fprintf(writer, "\treturn db.keys[fakeKeyValue(key, values...)]\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "func (db *FakeDatabase) addKey(key string, values ...interface{}) {\n")
+	fmt.Fprintf(writer, "\tdb.keys[fakeKeyValue(key, values...)] = true\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "func (db *FakeDatabase) removeKey(key string, values ...interface{}) {\n")
+	fmt.Fprintf(writer, "\tdelete(db.keys, fakeKeyValue(key, values...))\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "func (db *FakeDatabase) copyKeys() map[string]bool {\n")
+	fmt.Fprintf(writer, "\tresult := make(map[string]bool, len(db.keys))\n")
+	fmt.Fprintf(writer, "\tfor key := range db.keys {\n")
+	fmt.Fprintf(writer, "\t\tresult[key] = true\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn result\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "// nextValue is nextval of the sequence of the primary key of table\n")
+	fmt.Fprintf(writer, "func (db *FakeDatabase) nextValue(table string) int64 {\n")
+	fmt.Fprintf(writer, "\tdb.sequences[table]++\n")
+	fmt.Fprintf(writer, "\treturn db.sequences[table]\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "func (db *FakeDatabase) nextUUID(table string) string {\n")
+	fmt.Fprintf(writer, "\treturn fmt.Sprintf(\"00000000-0000-4000-8000-%%012d\", db.nextValue(table))\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "func notNullViolation(table string, column string) error {\n")
+	fmt.Fprintf(writer, "\treturn &pq.Error{Severity: \"ERROR\", Code: \"23502\", Table: table, Column: column,\n")
+	fmt.Fprintf(writer, "\t\tMessage: fmt.Sprintf(\"null value in column %%q of relation %%q violates not-null constraint\", column, table)}\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "func uniqueViolation(table string, constraint string, columns string, values ...interface{}) error {\n")
+	fmt.Fprintf(writer, "\treturn &pq.Error{Severity: \"ERROR\", Code: \"23505\", Table: table, Constraint: constraint,\n")
+	fmt.Fprintf(writer, "\t\tMessage: fmt.Sprintf(\"duplicate key value violates unique constraint %%q\", constraint),\n")
+	fmt.Fprintf(writer, "\t\tDetail:  fakeKeyValue(\"Key (\"+columns+\")\", values...) + \" already exists.\"}\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "func foreignKeyViolation(table string, constraint string, column string, refTable string, value interface{}) error {\n")
+	fmt.Fprintf(writer, "\treturn &pq.Error{Severity: \"ERROR\", Code: \"23503\", Table: table, Constraint: constraint,\n")
+	fmt.Fprintf(writer, "\t\tMessage: fmt.Sprintf(\"insert or update on table %%q violates foreign key constraint %%q\", table, constraint),\n")
+	fmt.Fprintf(writer, "\t\tDetail:  fmt.Sprintf(\"Key (%%s)=(%%v) is not present in table %%q.\", column, value, refTable)}\n")
+	fmt.Fprintf(writer, "}\n\n")
+	writeUserCodeRegion(writer, layout, USER_CODE_CODE)
+
+	writer.Flush()
+	return sink.writeFile(layout.fileName(KIND_DAO, FAKE_DATABASE_FILE_NAME), buffer.Bytes())
+}
+
+// generateGoFakeRepository writes <Entity>FakeRepository, an in-memory
+// <Entity>Repository checking the constraints of the table like postgres :
+// NOT NULL first, then the primary and unique keys, then the foreign keys
+func generateGoFakeRepository(layout *OutputLayout, sink OutputSink, table *Table) error {
+	names := layout.naming.tableNames(table)
+	columns := table.goColumns()
+	methods := repositoryMethods(layout, table)
+	entityType := layout.qualifier(KIND_DAO, KIND_MODEL) + names.Entity
+	fakeName := names.Entity + "FakeRepository"
+	softDelete := table.softDeleteColumn()
+	imported := []string{"context"}
+	withNullTime := softDelete != nil
+	withTime := softDelete != nil
+	for _, column := range columns {
+		if table.isAuditTimestamp(column) {
+			withNullTime = withNullTime || isNullWrapped(column)
+			withTime = true
+		}
+		withTime = withTime || table.initialVersion(column) == "now()"
+	}
+	if withNullTime {
+		imported = append(imported, "database/sql")
+	}
+	if withTime {
+		imported = append(imported, "time")
+	}
+	var buffer bytes.Buffer
+	writer := bufio.NewWriter(&buffer)
+	writeGeneratedHeader(writer, layout, KIND_DAO, table)
+	writeImports(writer, repositoryImports(layout, methods, imported...))
+	writeUserCodeRegion(writer, layout, USER_CODE_IMPORTS)
+
+	fmt.Fprintf(writer, "// %s keeps the %s rows in memory, the fake repositories of the\n", fakeName, table.Name)
+	fmt.Fprintf(writer, "// referenced tables must share its FakeDatabase\n")
+	fmt.Fprintf(writer, "type %s struct {\n", fakeName)
+	fmt.Fprintf(writer, "\tdb   *FakeDatabase\n")
+	fmt.Fprintf(writer, "\trows []*%s\n", entityType)
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "var _ %sRepository = (*%s)(nil)\n\n", names.Entity, fakeName)
+
+	fmt.Fprintf(writer, "func New%s(db_ *FakeDatabase) *%s {\n", fakeName, fakeName)
+	fmt.Fprintf(writer, "\treturn &%s{db: db_, rows: make([]*%s, 0, 0)}\n", fakeName, entityType)
+	fmt.Fprintf(writer, "}\n\n")
+
+	for _, method := range methods {
+		fmt.Fprintf(writer, "func (repository *%s) %s%s {\n", fakeName, method.Name, method.signature())
+		fmt.Fprintf(writer, "\tif err := ctx.Err(); err != nil {\n")
+		fmt.Fprintf(writer, "\t\treturn %s\n", method.errorResults("err"))
+		fmt.Fprintf(writer, "\t}\n")
+		fmt.Fprintf(writer, "\trepository.db.lock.Lock()\n")
+		fmt.Fprintf(writer, "\tdefer repository.db.lock.Unlock()\n")
+		switch method.Name {
+		case "LoadByID":
+			writeFakeLoadByID(writer, names, method, fakeNotDeleted(names, columns, softDelete))
+		case "LoadByIDIncludeDeleted":
+			writeFakeLoadByID(writer, names, method, "")
+		case "SoftDelete":
+			writeFakeSoftDelete(writer, table, names, columns, method, softDelete, true)
+		case "Restore":
+			writeFakeSoftDelete(writer, table, names, columns, method, softDelete, false)
+		case "Update":
+			writeFakeUpdate(writer, table, names, columns)
+		case "Create":
+			writeFakeCreate(writer, entityType, method)
+		case "BulkInsertReturning":
+			writeFakeBulkInsert(writer, entityType, findRepositoryMethod(methods, "Create"))
+		}
+		fmt.Fprintf(writer, "}\n\n")
+	}
+	writeFakeInsert(writer, layout, table, names, columns, fakeName, entityType)
+	if table.hasUpdate() {
+		writeFakeRemoveKeys(writer, layout, table, fakeName, entityType)
+	}
+	writeUserCodeRegion(writer, layout, USER_CODE_CODE)
+
+	writer.Flush()
+	return sink.writeFile(layout.fileName(KIND_DAO, fakeName), buffer.Bytes())
+}
+
+// fakeNotDeleted returns the condition of a row not soft deleted, empty for a
+// table without soft delete
+func fakeNotDeleted(names *TableNames, columns []*Column, softDelete *Column) string {
+	for i, column := range columns {
+		if column == softDelete {
+			_, notNull := fakeValue(column, names.Fields[i])
+			return "!" + notNull
+		}
+	}
+	return ""
+}
+
+// fakeKeyMatch returns the condition of the row matching params, the primary
+// key the DAO functions match, ex row.ID == id
+func fakeKeyMatch(params []*RepositoryParam) string {
+	conditions := make([]string, 0, len(params))
+	for _, param := range params {
+		conditions = append(conditions, "row."+param.Field+" == "+param.Name)
+	}
+	return strings.Join(conditions, " && ")
+}
+
+// writeFakeLoadByID matches the primary key like the where id=$1 of the DAO,
+// and the rows meeting condition when there is one
+func writeFakeLoadByID(writer *bufio.Writer, names *TableNames, method *RepositoryMethod, condition string) {
+	if condition != "" {
+		condition = " && " + condition
+	}
+	fmt.Fprintf(writer, "\tfor _, row := range repository.rows {\n")
+	fmt.Fprintf(writer, "\t\tif %s%s {\n", fakeKeyMatch(method.Params), condition)
+	fmt.Fprintf(writer, "\t\t\tresult := *row\n")
+	fmt.Fprintf(writer, "\t\t\treturn &result, nil\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn nil, %s\n", notFoundErrorName(names))
+}
+
+// writeFakeSoftDelete sets the soft delete column of the row not deleted to
+// now, or clears it on the deleted row to restore it, both refresh updated_at
+func writeFakeSoftDelete(writer *bufio.Writer, table *Table, names *TableNames, columns []*Column, method *RepositoryMethod, softDelete *Column, delete bool) {
+	updatedAt := table.updatedAtColumn()
+	for i, column := range columns {
+		if column != softDelete {
+			continue
+		}
+		condition := "!row." + names.Fields[i] + ".Valid"
+		value := "sql.NullTime{Time: time.Now(), Valid: true}"
+		if !delete {
+			condition = "row." + names.Fields[i] + ".Valid"
+			value = "sql.NullTime{}"
+		}
+		fmt.Fprintf(writer, "\tfor _, row := range repository.rows {\n")
+		fmt.Fprintf(writer, "\t\tif %s && %s {\n", fakeKeyMatch(method.Params), condition)
+		fmt.Fprintf(writer, "\t\t\trow.%s = %s\n", names.Fields[i], value)
+		for j, audit := range columns {
+			if audit == updatedAt && table.isAuditTimestamp(audit) {
+				fmt.Fprintf(writer, "\t\t\trow.%s = %s\n", names.Fields[j], auditFakeValue(audit))
+			}
+		}
+		fmt.Fprintf(writer, "\t\t\treturn nil\n")
+		fmt.Fprintf(writer, "\t\t}\n")
+		fmt.Fprintf(writer, "\t}\n")
+	}
+	fmt.Fprintf(writer, "\treturn %s\n", notFoundErrorName(names))
+}
+
+// writeFakeUpdate writes the row of entity like update<Entity> : ErrStaleEntity
+// when the version of entity is not the one of the row, the constraints
+// checked again with the keys of the row as updated
+func writeFakeUpdate(writer *bufio.Writer, table *Table, names *TableNames, columns []*Column) {
+	version := table.versionColumn()
+	keyParams := primaryKeyParams(table, names)
+	matches := make([]string, 0, len(keyParams))
+	for _, param := range keyParams {
+		matches = append(matches, "row."+param.Field+" == entity."+param.Field)
+	}
+	noRowError := notFoundErrorName(names)
+	if version != nil {
+		noRowError = "ErrStaleEntity"
+	}
+	fmt.Fprintf(writer, "\tfor _, row := range repository.rows {\n")
+	fmt.Fprintf(writer, "\t\tif %s {\n", strings.Join(matches, " && "))
+	for i, column := range columns {
+		if column != version {
+			continue
+		}
+		if isIntegerColumn(column) {
+			fmt.Fprintf(writer, "\t\t\tif row.%s != entity.%s {\n", names.Fields[i], names.Fields[i])
+		} else {
+			fmt.Fprintf(writer, "\t\t\tif !row.%s.Equal(entity.%s) {\n", names.Fields[i], names.Fields[i])
+		}
+		fmt.Fprintf(writer, "\t\t\t\treturn nil, ErrStaleEntity\n")
+		fmt.Fprintf(writer, "\t\t\t}\n")
+	}
+	fmt.Fprintf(writer, "\t\t\tupdated := *row\n")
+	updatedAt := table.updatedAtColumn()
+	for i, column := range columns {
+		switch {
+		case table.isUpdateParam(column):
+			fmt.Fprintf(writer, "\t\t\tupdated.%s = entity.%s\n", names.Fields[i], names.Fields[i])
+		case column == version && isIntegerColumn(column):
+			fmt.Fprintf(writer, "\t\t\tupdated.%s++\n", names.Fields[i])
+		case column == version:
+			fmt.Fprintf(writer, "\t\t\tupdated.%s = time.Now()\n", names.Fields[i])
+		case column == updatedAt && table.isAuditTimestamp(column):
+			fmt.Fprintf(writer, "\t\t\tupdated.%s = %s\n", names.Fields[i], auditFakeValue(column))
+		}
+	}
+	fmt.Fprintf(writer, "\t\t\tkeys := repository.db.copyKeys()\n")
+	fmt.Fprintf(writer, "\t\t\trepository.removeKeys(row)\n")
+	fmt.Fprintf(writer, "\t\t\tif err := repository.check(&updated); err != nil {\n")
+	fmt.Fprintf(writer, "\t\t\t\trepository.db.keys = keys\n")
+	fmt.Fprintf(writer, "\t\t\t\treturn nil, err\n")
+	fmt.Fprintf(writer, "\t\t\t}\n")
+	fmt.Fprintf(writer, "\t\t\t*row = updated\n")
+	fmt.Fprintf(writer, "\t\t\treturn &updated, nil\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn nil, %s\n", noRowError)
+}
+
+// insertFields returns the fields the insert sets, from the parameters of
+// Create, ex Name: name, or from the same fields of entity, ex Name: entity.Name
+func insertFields(create *RepositoryMethod, entity string) string {
+	fields := make([]string, 0, len(create.Params))
+	for _, param := range create.Params {
+		if entity == "" {
+			fields = append(fields, param.Field+": "+param.Name)
+		} else {
+			fields = append(fields, param.Field+": "+entity+"."+param.Field)
+		}
+	}
+	return strings.Join(fields, ", ")
+}
+
+func writeFakeCreate(writer *bufio.Writer, entityType string, method *RepositoryMethod) {
+	fmt.Fprintf(writer, "\trow := &%s{%s}\n", entityType, insertFields(method, ""))
+	fmt.Fprintf(writer, "\tif err := repository.insert(row); err != nil {\n")
+	fmt.Fprintf(writer, "\t\treturn nil, err\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\tresult := *row\n")
+	fmt.Fprintf(writer, "\treturn &result, nil\n")
+}
+
+// writeFakeBulkInsert inserts every entity or none, like the multi-row insert
+func writeFakeBulkInsert(writer *bufio.Writer, entityType string, create *RepositoryMethod) {
+	fmt.Fprintf(writer, "\tkeys := repository.db.copyKeys()\n")
+	fmt.Fprintf(writer, "\tcount := len(repository.rows)\n")
+	fmt.Fprintf(writer, "\tresult := make([]*%s, 0, len(entities))\n", entityType)
+	fmt.Fprintf(writer, "\tfor _, entity := range entities {\n")
+	fmt.Fprintf(writer, "\t\trow := &%s{%s}\n", entityType, insertFields(create, "entity"))
+	fmt.Fprintf(writer, "\t\tif err := repository.insert(row); err != nil {\n")
+	fmt.Fprintf(writer, "\t\t\trepository.db.keys = keys\n")
+	fmt.Fprintf(writer, "\t\t\trepository.rows = repository.rows[:count]\n")
+	fmt.Fprintf(writer, "\t\t\treturn nil, err\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t\tinserted := *row\n")
+	fmt.Fprintf(writer, "\t\tresult = append(result, &inserted)\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn result, nil\n")
+}
+
+// writeFakeInsert writes the insert method of the fake repository : it sets
+// the primary key of row, checks the constraints and keeps row, and the check
+// method the updates share
+func writeFakeInsert(writer *bufio.Writer, layout *OutputLayout, table *Table, names *TableNames, columns []*Column, fakeName string, entityType string) {
+	mapError := mapErrorFuncName(names)
+	fmt.Fprintf(writer, "func (repository *%s) insert(row *%s) error {\n", fakeName, entityType)
+
+	// the primary key the insert leaves to its default
+	for i, column := range columns {
+		if !column.IsPrimary {
+			continue
+		}
+		goType := columnGoType(column)
+		switch {
+		case goType == "int" || goType == "int16" || goType == "int64":
+			fmt.Fprintf(writer, "\trow.%s = %s(repository.db.nextValue(%q))\n", names.Fields[i], goType, table.Name)
+		case goType == "string" && column.Type == "uuid":
+			fmt.Fprintf(writer, "\trow.%s = repository.db.nextUUID(%q)\n", names.Fields[i], table.Name)
+		}
+	}
+
+	for i, column := range columns {
+		if table.isAuditTimestamp(column) {
+			fmt.Fprintf(writer, "\trow.%s = %s\n", names.Fields[i], auditFakeValue(column))
+		} else if version := table.initialVersion(column); version == "now()" {
+			fmt.Fprintf(writer, "\trow.%s = time.Now()\n", names.Fields[i])
+		} else if version != "" {
+			fmt.Fprintf(writer, "\trow.%s = %s\n", names.Fields[i], version)
+		}
+	}
+	fmt.Fprintf(writer, "\tif err := repository.check(row); err != nil {\n")
+	fmt.Fprintf(writer, "\t\treturn err\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\trepository.rows = append(repository.rows, row)\n")
+	fmt.Fprintf(writer, "\treturn nil\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "func (repository *%s) check(row *%s) error {\n", fakeName, entityType)
+	for i, column := range columns {
+		if !column.IsNullable && !column.IsPrimary && !column.ReadOnly && isNilable(columnGoType(column)) {
+			fmt.Fprintf(writer, "\tif row.%s == nil {\n", names.Fields[i])
+			fmt.Fprintf(writer, "\t\treturn %s(notNullViolation(%q, %q))\n", mapError, table.Name, column.Name)
+			fmt.Fprintf(writer, "\t}\n")
+		}
+	}
+
+	keys := fakeKeys(layout, table)
+	keyValues, keyConditions := fakeKeyValues(keys)
+	for k, key := range keys {
+		condition := fmt.Sprintf("repository.db.hasKey(%q, %s)", key.name(), keyValues[k])
+		if keyConditions[k] != "" {
+			condition = keyConditions[k] + " && " + condition
+		}
+		columnNames := make([]string, 0, len(key.Columns))
+		for _, column := range key.Columns {
+			columnNames = append(columnNames, column.Name)
+		}
+		fmt.Fprintf(writer, "\tif %s {\n", condition)
+		fmt.Fprintf(writer, "\t\treturn %s(uniqueViolation(%q, %q, %q, %s))\n", mapError, table.Name, key.Constraint, strings.Join(columnNames, ", "), keyValues[k])
+		fmt.Fprintf(writer, "\t}\n")
+	}
+
+	for _, foreignKey := range table.foreignKeys {
+		if foreignKey.ColumnName == "" {
+			continue
+		}
+		for i, column := range columns {
+			if column.Name != foreignKey.ColumnName {
+				continue
+			}
+			value, condition := fakeValue(column, names.Fields[i])
+			check := fmt.Sprintf("!repository.db.hasKey(%q, %s)", fakeKeyName(foreignKey.RefTable, []string{foreignKey.RefColumn}), value)
+			if condition != "" {
+				check = condition + " && " + check
+			}
+			fmt.Fprintf(writer, "\tif %s {\n", check)
+			fmt.Fprintf(writer, "\t\treturn %s(foreignKeyViolation(%q, %q, %q, %q, %s))\n", mapError, table.Name, foreignKey.Name, column.Name, foreignKey.RefTable, value)
+			fmt.Fprintf(writer, "\t}\n")
+		}
+	}
+
+	for k, key := range keys {
+		if keyConditions[k] != "" {
+			fmt.Fprintf(writer, "\tif %s {\n", keyConditions[k])
+			fmt.Fprintf(writer, "\t\trepository.db.addKey(%q, %s)\n", key.name(), keyValues[k])
+			fmt.Fprintf(writer, "\t}\n")
+		} else {
+			fmt.Fprintf(writer, "\trepository.db.addKey(%q, %s)\n", key.name(), keyValues[k])
+		}
+	}
+	fmt.Fprintf(writer, "\treturn nil\n")
+	fmt.Fprintf(writer, "}\n\n")
+}
+
+// fakeKeyValues returns the values of every key in row and the condition of
+// a key without null column, postgres ignores a key with a null column
+func fakeKeyValues(keys []*FakeKey) ([]string, []string) {
+	keyValues := make([]string, len(keys))
+	keyConditions := make([]string, len(keys))
+	for k, key := range keys {
+		values := make([]string, 0, len(key.Columns))
+		conditions := make([]string, 0, 0)
+		for i, column := range key.Columns {
+			value, condition := fakeValue(column, key.Fields[i])
+			values = append(values, value)
+			if condition != "" {
+				conditions = append(conditions, condition)
+			}
+		}
+		keyValues[k] = strings.Join(values, ", ")
+		keyConditions[k] = strings.Join(conditions, " && ")
+	}
+	return keyValues, keyConditions
+}
+
+// writeFakeRemoveKeys writes the removeKeys method, freeing the keys of a row
+// before its update checks them again
+func writeFakeRemoveKeys(writer *bufio.Writer, layout *OutputLayout, table *Table, fakeName string, entityType string) {
+	keys := fakeKeys(layout, table)
+	keyValues, keyConditions := fakeKeyValues(keys)
+	fmt.Fprintf(writer, "func (repository *%s) removeKeys(row *%s) {\n", fakeName, entityType)
+	for k, key := range keys {
+		if keyConditions[k] != "" {
+			fmt.Fprintf(writer, "\tif %s {\n", keyConditions[k])
+			fmt.Fprintf(writer, "\t\trepository.db.removeKey(%q, %s)\n", key.name(), keyValues[k])
+			fmt.Fprintf(writer, "\t}\n")
+		} else {
+			fmt.Fprintf(writer, "\trepository.db.removeKey(%q, %s)\n", key.name(), keyValues[k])
+		}
+	}
+	fmt.Fprintf(writer, "}\n\n")
+}
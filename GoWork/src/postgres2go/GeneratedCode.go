@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/lib/pq"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+const GENERATED_HEADER = "// Code generated by postgres2go; DO NOT EDIT."
+const USER_CODE_BEGIN = "// BEGIN USER CODE"
+const USER_CODE_END = "// END USER CODE"
+
+const PQ_IMPORT_PATH = "github.com/lib/pq"
+
+const (
+	USER_CODE_IMPORTS = "imports"
+	USER_CODE_CODE    = "code"
+)
+
+// sqlColumnList returns the quoted names of columns for a select or a
+// returning, ex "id","display name", every name in the sql is quoted
+func sqlColumnList(columns []*Column) string {
+	names := make([]string, 0, len(columns))
+	for _, column := range columns {
+		names = append(names, pq.QuoteIdentifier(column.Name))
+	}
+	return strings.Join(names, ",")
+}
+
+func writeGeneratedHeader(writer io.Writer, layout *OutputLayout, kind string, table *Table) {
+	fmt.Fprintf(writer, "%s\n", GENERATED_HEADER)
+	fmt.Fprintf(writer, "// table %s, schema fingerprint %s\n\n", table.Name, table.fingerprint())
+	fmt.Fprintf(writer, "package %s\n\n", layout.packageName(kind))
+}
+
+// writeSupportHeader is the header of the files shared by every table
+func writeSupportHeader(writer io.Writer, layout *OutputLayout, kind string) {
+	fmt.Fprintf(writer, "%s\n\n", GENERATED_HEADER)
+	fmt.Fprintf(writer, "package %s\n\n", layout.packageName(kind))
+}
+
+// writeUserCodeRegion emits an empty region, its content is restored from the
+// file on disk by mergeUserCode
+func writeUserCodeRegion(writer io.Writer, layout *OutputLayout, name string) {
+	if layout.config.UserCode {
+		fmt.Fprintf(writer, "%s %s\n%s\n\n", USER_CODE_BEGIN, name, USER_CODE_END)
+	}
+}
+
+func isGeneratedContent(content string) bool {
+	for _, line := range strings.SplitN(content, "\n", 10) {
+		if strings.TrimSpace(line) == GENERATED_HEADER {
+			return true
+		}
+	}
+	return false
+}
+
+// userCodeRegions returns the body of every named region, verbatim
+func userCodeRegions(content string) (map[string]string, []string, error) {
+	regions := make(map[string]string)
+	names := make([]string, 0, 0)
+	name := ""
+	inRegion := false
+	var body strings.Builder
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(content)+1)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, USER_CODE_BEGIN) {
+			if inRegion {
+				return nil, nil, fmt.Errorf("line %d : nested %s", lineNumber, USER_CODE_BEGIN)
+			}
+			name = strings.TrimSpace(strings.TrimPrefix(trimmed, USER_CODE_BEGIN))
+			if _, exists := regions[name]; exists {
+				return nil, nil, fmt.Errorf("line %d : duplicate user code region [%s]", lineNumber, name)
+			}
+			inRegion = true
+			body.Reset()
+		} else if trimmed == USER_CODE_END {
+			if !inRegion {
+				return nil, nil, fmt.Errorf("line %d : %s without %s", lineNumber, USER_CODE_END, USER_CODE_BEGIN)
+			}
+			regions[name] = body.String()
+			names = append(names, name)
+			inRegion = false
+		} else if inRegion {
+			body.WriteString(line)
+			body.WriteString("\n")
+		}
+	}
+	if inRegion {
+		return nil, nil, errors.New("unterminated user code region [" + name + "]")
+	}
+	return regions, names, nil
+}
+
+// mergeUserCode copies the user code regions of the file on disk into the newly generated content
+func mergeUserCode(fileName string, content []byte) ([]byte, error) {
+	current, err := ioutil.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return content, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !strings.Contains(string(current), USER_CODE_BEGIN) {
+		return content, nil
+	}
+	userRegions, userNames, err := userCodeRegions(string(current))
+	if err != nil {
+		return nil, fmt.Errorf("%s : %s", fileName, err.Error())
+	}
+	_, generatedNames, err := userCodeRegions(string(content))
+	if err != nil {
+		return nil, err
+	}
+	generated := make(map[string]bool)
+	for _, name := range generatedNames {
+		generated[name] = true
+	}
+	for _, name := range userNames {
+		if !generated[name] && strings.TrimSpace(userRegions[name]) != "" {
+			return nil, fmt.Errorf("%s : user code region [%s] would be lost, the generator no longer emits it", fileName, name)
+		}
+	}
+
+	var merged strings.Builder
+	name := ""
+	for _, line := range strings.SplitAfter(string(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, USER_CODE_BEGIN) {
+			name = strings.TrimSpace(strings.TrimPrefix(trimmed, USER_CODE_BEGIN))
+			merged.WriteString(line)
+			merged.WriteString(userRegions[name])
+		} else {
+			merged.WriteString(line)
+		}
+	}
+	return []byte(merged.String()), nil
+}
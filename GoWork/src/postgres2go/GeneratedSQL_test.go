@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// the words the generated sql may hold unquoted, any other word is a table or
+// column name a generator forgot to quote
+var GENERATED_SQL_KEYWORDS = map[string]bool{
+	"select": true, "from": true, "where": true, "and": true, "or": true, "in": true, "like": true,
+	"insert": true, "into": true, "values": true, "returning": true, "update": true, "set": true,
+	"is": true, "not": true, "null": true, "false": true, "now": true, "count": true,
+	"order": true, "by": true, "asc": true, "desc": true, "limit": true, "offset": true,
+	"declare": true, "no": true, "scroll": true, "cursor": true, "for": true, "fetch": true, "close": true,
+	ITERATE_CURSOR_NAME: true,
+}
+
+// the words a table or column name follows
+var GENERATED_SQL_NAME_KEYWORDS = map[string]bool{"from": true, "into": true, "update": true, "set": true, "returning": true}
+
+// the fields of the query builder holding one quoted name
+var GENERATED_SQL_NAME_FIELDS = map[string]bool{"name": true, "table": true, "softDelete": true}
+
+// checkSQLLiteral returns an error when the sql of a go string literal holds a
+// name that is not quoted, a name literal is one quoted name or empty
+func checkSQLLiteral(literal string, name bool) error {
+	sql, err := strconv.Unquote(literal)
+	if err != nil {
+		return fmt.Errorf("%s : %s", literal, err)
+	}
+	tokens, err := tokenizeSQL(sql)
+	if err != nil {
+		return fmt.Errorf("%q : %s", sql, err)
+	}
+	if name {
+		if len(tokens) > 1 || len(tokens) == 1 && tokens[0].kind != SQL_IDENTIFIER {
+			return fmt.Errorf("%q is not one quoted name", sql)
+		}
+		return nil
+	}
+	for index, token := range tokens {
+		if token.kind != SQL_WORD {
+			continue
+		}
+		if !GENERATED_SQL_KEYWORDS[token.value] {
+			return fmt.Errorf("%s is not quoted in %q", token.value, sql)
+		}
+		if GENERATED_SQL_NAME_KEYWORDS[token.value] && index+1 < len(tokens) {
+			next := tokens[index+1]
+			if next.kind != SQL_IDENTIFIER && next.value != ITERATE_CURSOR_NAME {
+				return fmt.Errorf("%s follows %s unquoted in %q", next.value, token.value, sql)
+			}
+		}
+	}
+	return nil
+}
+
+// sqlLiterals returns the string literals of an expression, the arguments of
+// the calls it holds are not sql
+func sqlLiterals(expression ast.Node) []*ast.BasicLit {
+	result := make([]*ast.BasicLit, 0, 0)
+	ast.Inspect(expression, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.CallExpr:
+			return false
+		case *ast.BasicLit:
+			if node.Kind == token.STRING {
+				result = append(result, node)
+			}
+		}
+		return true
+	})
+	return result
+}
+
+// checkGeneratedSQL returns an error for each sql string of the generated
+// files holding a name that is not quoted, so a golden cannot lock in invalid
+// sql. The sql strings are the queries given to QueryContext, QueryRowContext
+// and ExecContext, the query and fetch variables, the column lists given to
+// build and the names of the query builder
+func checkGeneratedSQL(files map[string]string) []string {
+	errors := make([]string, 0, 0)
+	for _, relative := range sortedKeys(files) {
+		if !strings.HasSuffix(relative, ".go") {
+			continue
+		}
+		fset := token.NewFileSet()
+		parsed, err := parser.ParseFile(fset, relative, files[relative], 0)
+		if err != nil {
+			// typeCheckGenerated reports it
+			continue
+		}
+		check := func(expression ast.Expr, name bool) {
+			for _, literal := range sqlLiterals(expression) {
+				if err := checkSQLLiteral(literal.Value, name); err != nil {
+					errors = append(errors, fmt.Sprintf("%s : %s", fset.Position(literal.Pos()), err))
+				}
+			}
+		}
+		ast.Inspect(parsed, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.CallExpr:
+				selector, isSelector := node.Fun.(*ast.SelectorExpr)
+				if !isSelector {
+					break
+				}
+				switch selector.Sel.Name {
+				case "QueryContext", "QueryRowContext", "ExecContext":
+					if len(node.Args) > 1 {
+						check(node.Args[1], false)
+					}
+				case "build":
+					for _, argument := range node.Args {
+						check(argument, false)
+					}
+				}
+			case *ast.AssignStmt:
+				if len(node.Lhs) != len(node.Rhs) {
+					break
+				}
+				for index, left := range node.Lhs {
+					if ident, isIdent := left.(*ast.Ident); isIdent && (ident.Name == "query" || ident.Name == "fetch") {
+						check(node.Rhs[index], false)
+					}
+				}
+			case *ast.KeyValueExpr:
+				if key, isIdent := node.Key.(*ast.Ident); isIdent && GENERATED_SQL_NAME_FIELDS[key.Name] {
+					check(node.Value, true)
+				}
+			}
+			return true
+		})
+	}
+	return errors
+}
+
+func TestCheckGeneratedSQL(t *testing.T) {
+	source := `package generated
+
+func queries(ctx context.Context, q DBTX) {
+	q.QueryContext(ctx, "select \"id\",\"type\" from \"select\" where \"id\"=$1", 1)
+	q.QueryContext(ctx, "select \"id\",\"type\" from select where \"id\"=$1", 1)
+	q.ExecContext(ctx, "update \"event\" set \"deleted_at\"=now() where id=$1", 1)
+	q.QueryContext(ctx, "insert into \"api_client\"(\"display name\") values "+valuesPlaceholders(2, 1)+" returning display name", args...)
+	query := "declare postgres2go_cursor no scroll cursor for select \"id\" from \"event\""
+	fetch := "fetch " + strconv.Itoa(fetchSize) + " from postgres2go_cursor"
+	builder.query.build("\"id\",type")
+	_ = OrgColumn{name: "\"id\""}
+	_ = OrgColumn{name: "display name"}
+	_ = queryBuilder{table: "\"org\"", softDelete: ""}
+}
+`
+	errors := checkGeneratedSQL(map[string]string{"Queries.go": source})
+	expected := []string{
+		"Queries.go:5:22 : select follows from unquoted",
+		"Queries.go:6:21 : id is not quoted",
+		"Queries.go:7:102 : display follows returning unquoted",
+		"Queries.go:10:22 : type is not quoted",
+		"Queries.go:12:22 : \"display name\" is not one quoted name",
+	}
+	if len(errors) != len(expected) {
+		t.Fatalf("checkGeneratedSQL returned %d errors, want %d :\n%s", len(errors), len(expected), strings.Join(errors, "\n"))
+	}
+	for index, prefix := range expected {
+		if !strings.HasPrefix(errors[index], prefix) {
+			t.Errorf("error %d is %q, want it to start with %q", index, errors[index], prefix)
+		}
+	}
+}
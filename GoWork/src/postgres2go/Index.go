@@ -0,0 +1,14 @@
+package main
+
+type Index struct {
+	Name       string
+	Definition string
+	Constraint string
+	IsPrimary  bool
+	IsUnique   bool
+	Columns    []string
+}
+
+func NewIndex(name_ string, definition_ string, constraint_ string, isprimary_ bool, isunique_ bool, columns_ []string) *Index {
+	return &Index{Name: name_, Definition: definition_, Constraint: constraint_, IsPrimary: isprimary_, IsUnique: isunique_, Columns: columns_}
+}
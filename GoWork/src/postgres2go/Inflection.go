@@ -0,0 +1,85 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+// words with the same singular and plural form
+var UNCOUNTABLE_WORDS = map[string]bool{
+	"data": true, "equipment": true, "feedback": true, "fish": true, "information": true,
+	"metadata": true, "money": true, "news": true, "series": true, "sheep": true,
+	"species": true, "software": true,
+}
+
+var IRREGULAR_PLURALS = map[string]string{
+	"aliases": "alias", "analyses": "analysis", "buses": "bus", "campuses": "campus",
+	"children": "child", "cookies": "cookie", "crises": "crisis", "feet": "foot", "geese": "goose",
+	"indices": "index", "knives": "knife", "lives": "life", "matrices": "matrix",
+	"men": "man", "mice": "mouse", "movies": "movie", "oxen": "ox",
+	"people": "person", "shoes": "shoe", "statuses": "status", "teeth": "tooth",
+	"theses": "thesis", "vertices": "vertex", "viruses": "virus", "wives": "wife",
+	"women": "woman",
+}
+
+// suffix rules, the first matching one wins
+var SINGULAR_RULES = [][2]string{
+	{"ss", "ss"},
+	{"us", "us"},
+	{"is", "is"},
+	{"ies", "y"},
+	{"sses", "ss"},
+	{"xes", "x"},
+	{"ches", "ch"},
+	{"shes", "sh"},
+	{"zzes", "zz"},
+	{"lves", "lf"},
+	{"oes", "o"},
+	{"s", ""},
+}
+
+// singularize returns the singular of an english word, keeping the case of
+// its first letter, ex categories --> category, Addresses --> Address
+func singularize(word string) string {
+	lower := strings.ToLower(word)
+	if UNCOUNTABLE_WORDS[lower] {
+		return word
+	}
+	if singular, exists := IRREGULAR_PLURALS[lower]; exists {
+		runes := []rune(singular)
+		if unicode.IsUpper([]rune(word)[0]) {
+			runes[0] = unicode.ToUpper(runes[0])
+		}
+		return string(runes)
+	}
+	for _, rule := range SINGULAR_RULES {
+		if strings.HasSuffix(lower, rule[0]) && rule[0] == rule[1] {
+			return word
+		}
+		// a too short stem is not a plural, ex ties --> tie and not ty
+		if strings.HasSuffix(lower, rule[0]) && len(lower) > len(rule[0])+1 {
+			return word[:len(word)-len(rule[0])] + rule[1]
+		}
+	}
+	return word
+}
+
+// singularTableName singularizes the last word of a table name, ex
+// user_addresses --> user_address, the overrides are looked up by table name
+// first and by word then
+func singularTableName(tableName string, overrides map[string]string) string {
+	if singular, exists := overrides[tableName]; exists {
+		return singular
+	}
+	words := splitWords(tableName)
+	if len(words) == 0 {
+		return tableName
+	}
+	last := words[len(words)-1]
+	singular, exists := overrides[strings.ToLower(last)]
+	if !exists {
+		singular = singularize(last)
+	}
+	index := strings.LastIndex(tableName, last)
+	return tableName[:index] + singular + tableName[index+len(last):]
+}
@@ -0,0 +1,261 @@
+package main
+
+import (
+	"bufio"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// Introspector reads the schema the generators work on, from the postgres
+// catalog, a snapshot file, migration files or a fixture built in memory
+type Introspector interface {
+	databaseName() string
+	listTables() ([]string, error)
+	describeTable(tableName string) (*Table, error)
+	describeColumns(table *Table) error
+	describePrimaryKey(table *Table) error
+	describeForeignKeys(table *Table) error
+	describeIndexes(table *Table) error
+	listEnums() ([]*Enum, error)
+	close() error
+}
+
+// CatalogIntrospector queries pg_catalog through a live connection
+type CatalogIntrospector struct {
+	db       *sql.DB
+	database string
+}
+
+func NewCatalogIntrospector(db_ *sql.DB, database_ string) *CatalogIntrospector {
+	return &CatalogIntrospector{db: db_, database: database_}
+}
+
+func (introspector *CatalogIntrospector) databaseName() string {
+	return introspector.database
+}
+
+func (introspector *CatalogIntrospector) listTables() ([]string, error) {
+	return readTableList(introspector.db)
+}
+
+func (introspector *CatalogIntrospector) describeTable(tableName string) (*Table, error) {
+	return getTableNameOID(introspector.db, tableName)
+}
+
+func (introspector *CatalogIntrospector) describeColumns(table *Table) error {
+	return getColumnsList(introspector.db, table)
+}
+
+func (introspector *CatalogIntrospector) describePrimaryKey(table *Table) error {
+	return getPrimaryKeyConstraint(introspector.db, table)
+}
+
+func (introspector *CatalogIntrospector) describeForeignKeys(table *Table) error {
+	return getForeignKeysList(introspector.db, table)
+}
+
+func (introspector *CatalogIntrospector) describeIndexes(table *Table) error {
+	return getIndexesList(introspector.db, table)
+}
+
+func (introspector *CatalogIntrospector) listEnums() ([]*Enum, error) {
+	return readEnumList(introspector.db)
+}
+
+func (introspector *CatalogIntrospector) close() error {
+	return introspector.db.Close()
+}
+
+// MemoryIntrospector serves a schema held in memory, it backs snapshot files,
+// migration files and the fixtures of the tests. Every describe call returns
+// copies so the resolved primary and foreign key flags never leak back
+type MemoryIntrospector struct {
+	schema *Schema
+	tables map[string]*Table
+}
+
+func NewMemoryIntrospector(schema_ *Schema) *MemoryIntrospector {
+	introspector := &MemoryIntrospector{schema: schema_, tables: make(map[string]*Table)}
+	for _, table := range schema_.Tables {
+		introspector.tables[table.Name] = table
+	}
+	return introspector
+}
+
+func NewSnapshotIntrospector(fileName string) (*MemoryIntrospector, error) {
+	snapshot, err := readSnapshot(fileName)
+	if err != nil {
+		return nil, err
+	}
+	return NewMemoryIntrospector(snapshot.toSchema()), nil
+}
+
+func NewDDLIntrospector(logWriter *bufio.Writer, directory string) (*MemoryIntrospector, error) {
+	schema, err := parseDDLDirectory(logWriter, directory)
+	if err != nil {
+		return nil, err
+	}
+	return NewMemoryIntrospector(schema), nil
+}
+
+func (introspector *MemoryIntrospector) lookup(tableName string) (*Table, error) {
+	table, exists := introspector.tables[tableName]
+	if !exists {
+		return nil, errors.New("No Results")
+	}
+	return table, nil
+}
+
+func (introspector *MemoryIntrospector) databaseName() string {
+	return introspector.schema.Database
+}
+
+func (introspector *MemoryIntrospector) listTables() ([]string, error) {
+	result := make([]string, 0, len(introspector.schema.Tables))
+	for _, table := range introspector.schema.Tables {
+		result = append(result, table.Name)
+	}
+	return result, nil
+}
+
+func (introspector *MemoryIntrospector) describeTable(tableName string) (*Table, error) {
+	source, err := introspector.lookup(tableName)
+	if err != nil {
+		return nil, err
+	}
+	table := NewTable(source.Oid, source.Name)
+	table.Comment = source.Comment
+	return table, nil
+}
+
+func (introspector *MemoryIntrospector) describeColumns(table *Table) error {
+	source, err := introspector.lookup(table.Name)
+	if err != nil {
+		return err
+	}
+	table.columns = make([]*Column, 0, len(source.columns))
+	for _, sourceColumn := range source.columns {
+		column := NewColumn(sourceColumn.Name, sourceColumn.Type, sourceColumn.IsNullable)
+		column.Comment = sourceColumn.Comment
+		table.columns = append(table.columns, column)
+	}
+	return nil
+}
+
+func (introspector *MemoryIntrospector) describePrimaryKey(table *Table) error {
+	source, err := introspector.lookup(table.Name)
+	if err != nil {
+		return err
+	}
+	if source.PrimaryKeyConstraint == "" {
+		return errors.New("No PrimaryKey")
+	}
+	table.PrimaryKeyName = source.PrimaryKeyName
+	table.PrimaryKeyConstraint = source.PrimaryKeyConstraint
+	return nil
+}
+
+func (introspector *MemoryIntrospector) describeForeignKeys(table *Table) error {
+	source, err := introspector.lookup(table.Name)
+	if err != nil {
+		return err
+	}
+	table.foreignKeys = make([]*ForeignKey, 0, len(source.foreignKeys))
+	for _, foreignKey := range source.foreignKeys {
+		table.foreignKeys = append(table.foreignKeys, NewForeignKey(foreignKey.Name, foreignKey.ForeignKeyConstraint))
+	}
+	return nil
+}
+
+func (introspector *MemoryIntrospector) describeIndexes(table *Table) error {
+	source, err := introspector.lookup(table.Name)
+	if err != nil {
+		return err
+	}
+	table.indexes = make([]*Index, 0, len(source.indexes))
+	for _, index := range source.indexes {
+		columns := append(make([]string, 0, len(index.Columns)), index.Columns...)
+		table.indexes = append(table.indexes, NewIndex(index.Name, index.Definition, index.Constraint, index.IsPrimary, index.IsUnique, columns))
+	}
+	return nil
+}
+
+func (introspector *MemoryIntrospector) listEnums() ([]*Enum, error) {
+	result := make([]*Enum, 0, len(introspector.schema.Enums))
+	for _, source := range introspector.schema.Enums {
+		enum := NewEnum(source.Name)
+		enum.Values = append(enum.Values, source.Values...)
+		result = append(result, enum)
+	}
+	return result, nil
+}
+
+func (introspector *MemoryIntrospector) close() error {
+	return nil
+}
+
+func descTable(logWriter *bufio.Writer, introspector Introspector, tableName string) (*Table, error) {
+	table, err := introspector.describeTable(tableName)
+	if err != nil {
+		return nil, fmt.Errorf("%s : %s", tableName, err.Error())
+	}
+	fmt.Fprintf(logWriter, "%s::%s ", table.Oid, table.Name)
+	err = introspector.describeColumns(table)
+	if err != nil {
+		return table, err
+	}
+	fmt.Fprintf(logWriter, " columns::%d ", len(table.columns))
+
+	err = introspector.describePrimaryKey(table)
+	if err != nil {
+		fmt.Fprintf(logWriter, " NoPrimaryKey ")
+	} else {
+		fmt.Fprintf(logWriter, " %s::%s ", table.PrimaryKeyName, table.PrimaryKeyConstraint)
+	}
+
+	err = introspector.describeForeignKeys(table)
+	if err != nil {
+		fmt.Fprintf(logWriter, " NoForeignKeys ")
+	} else {
+		fmt.Fprintf(logWriter, " foreignKeys::%d ", len(table.foreignKeys))
+	}
+
+	err = introspector.describeIndexes(table)
+	if err != nil {
+		fmt.Fprintf(logWriter, " NoIndexes\n")
+	} else {
+		fmt.Fprintf(logWriter, " indexes::%d\n", len(table.indexes))
+	}
+	return table, nil
+}
+
+// readSchema describes every table, then flags primary and foreign key columns
+func readSchema(logWriter *bufio.Writer, introspector Introspector) (*Schema, error) {
+	fmt.Fprintln(logWriter, "Describe tables")
+	fmt.Fprintln(console, "Describe tables")
+	schema := NewSchema(introspector.databaseName())
+	tableList, err := introspector.listTables()
+	if err != nil {
+		return nil, err
+	}
+	for _, tableName := range tableList {
+		table, err := descTable(logWriter, introspector, tableName)
+		if err != nil {
+			fmt.Fprintln(logWriter, err)
+			fmt.Fprintln(console, err)
+		}
+		if nil != table {
+			schema.Tables = append(schema.Tables, table)
+		}
+	}
+	enums, err := introspector.listEnums()
+	if err != nil {
+		return nil, err
+	}
+	schema.Enums = enums
+	fmt.Fprintf(logWriter, "enums::%d\n", len(schema.Enums))
+	schema.resolveEnums()
+	schema.resolveConstraints(logWriter)
+	return schema, nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"github.com/lib/pq"
+	"io"
+)
+
+// the cursor lives in the transaction of the iteration, one name is enough
+const ITERATE_CURSOR_NAME = "postgres2go_cursor"
+const ITERATE_DEFAULT_FETCH_SIZE = 1000
+
+// writeIterators writes iterate<Entity>, streaming the rows of a query, and
+// iterate<Entity>WithCursor, fetching them by batches from a cursor so the
+// driver never holds the whole result, with their IncludeDeleted variants
+// when the table has a soft delete column
+func writeIterators(writer io.Writer, layout *OutputLayout, table *Table, names *TableNames, columns []*Column) {
+	entityType := layout.qualifier(KIND_DAO, KIND_MODEL) + names.Entity
+	columnNames := make([]string, 0, len(columns))
+	for _, column := range columns {
+		columnNames = append(columnNames, column.Name)
+	}
+	query := "select " + sqlColumnList(columns) + " from " + pq.QuoteIdentifier(table.Name)
+
+	for _, variant := range softDeleteVariants(table) {
+		fmt.Fprintf(writer, "// %s calls fn with every %s row matching where, ex \"%s > $1\" with\n", layout.funcName("iterate"+names.Entity+variant.Suffix), table.Name, columnNames[0])
+		fmt.Fprintf(writer, "// its args, an empty where reads the whole table, an error of fn stops the iteration\n")
+		if variant.Condition != "" {
+			fmt.Fprintf(writer, "// the soft deleted rows are skipped, %s reads them too\n", layout.funcName("iterate"+names.Entity+"IncludeDeleted"))
+		}
+		fmt.Fprintf(writer, "func %s(ctx context.Context, q DBTX, where string, args []interface{}, fn func(*%s) error) error {\n", layout.funcName("iterate"+names.Entity+variant.Suffix), entityType)
+		writeIterateQuery(writer, query, variant)
+		fmt.Fprintf(writer, "\trows, err := q.QueryContext(ctx, query, args...)\n")
+		fmt.Fprintf(writer, "\tif err != nil {\n")
+		fmt.Fprintf(writer, "\t\treturn err\n")
+		fmt.Fprintf(writer, "\t}\n")
+		fmt.Fprintf(writer, "\tdefer rows.Close()\n")
+		fmt.Fprintf(writer, "\tfor rows.Next() {\n")
+		fmt.Fprintf(writer, "\t\tentity, err := rowsNoFetchResultSetTo%s(rows)\n", names.Entity)
+		fmt.Fprintf(writer, "\t\tif err != nil {\n")
+		fmt.Fprintf(writer, "\t\t\treturn err\n")
+		fmt.Fprintf(writer, "\t\t}\n")
+		fmt.Fprintf(writer, "\t\terr = fn(entity)\n")
+		fmt.Fprintf(writer, "\t\tif err != nil {\n")
+		fmt.Fprintf(writer, "\t\t\treturn err\n")
+		fmt.Fprintf(writer, "\t\t}\n")
+		fmt.Fprintf(writer, "\t}\n")
+		fmt.Fprintf(writer, "\treturn rows.Err()\n")
+		fmt.Fprintf(writer, "}\n\n")
+
+		fmt.Fprintf(writer, "// %s is %s reading the rows fetchSize at a\n", layout.funcName("iterate"+names.Entity+"WithCursor"+variant.Suffix), layout.funcName("iterate"+names.Entity+variant.Suffix))
+		fmt.Fprintf(writer, "// time from a cursor, in a transaction of its own, %d when fetchSize is not positive\n", ITERATE_DEFAULT_FETCH_SIZE)
+		fmt.Fprintf(writer, "func %s(ctx context.Context, db *sql.DB, where string, args []interface{}, fetchSize int, fn func(*%s) error) error {\n", layout.funcName("iterate"+names.Entity+"WithCursor"+variant.Suffix), entityType)
+		fmt.Fprintf(writer, "\tif fetchSize <= 0 {\n")
+		fmt.Fprintf(writer, "\t\tfetchSize = %d\n", ITERATE_DEFAULT_FETCH_SIZE)
+		fmt.Fprintf(writer, "\t}\n")
+		writeIterateQuery(writer, "declare "+ITERATE_CURSOR_NAME+" no scroll cursor for "+query, variant)
+		fmt.Fprintf(writer, "\treturn %s(ctx, db, func(tx *sql.Tx) error {\n", layout.funcName("withTx"))
+		fmt.Fprintf(writer, "\t\t_, err := tx.ExecContext(ctx, query, args...)\n")
+		fmt.Fprintf(writer, "\t\tif err != nil {\n")
+		fmt.Fprintf(writer, "\t\t\treturn err\n")
+		fmt.Fprintf(writer, "\t\t}\n")
+		fmt.Fprintf(writer, "\t\tfetch := \"fetch \" + strconv.Itoa(fetchSize) + %q\n", " from "+ITERATE_CURSOR_NAME)
+		fmt.Fprintf(writer, "\t\tfor {\n")
+		fmt.Fprintf(writer, "\t\t\trows, err := tx.QueryContext(ctx, fetch)\n")
+		fmt.Fprintf(writer, "\t\t\tif err != nil {\n")
+		fmt.Fprintf(writer, "\t\t\t\treturn err\n")
+		fmt.Fprintf(writer, "\t\t\t}\n")
+		fmt.Fprintf(writer, "\t\t\tcount := 0\n")
+		fmt.Fprintf(writer, "\t\t\tfor rows.Next() {\n")
+		fmt.Fprintf(writer, "\t\t\t\tcount++\n")
+		fmt.Fprintf(writer, "\t\t\t\tentity, err := rowsNoFetchResultSetTo%s(rows)\n", names.Entity)
+		fmt.Fprintf(writer, "\t\t\t\tif err == nil {\n")
+		fmt.Fprintf(writer, "\t\t\t\t\terr = fn(entity)\n")
+		fmt.Fprintf(writer, "\t\t\t\t}\n")
+		fmt.Fprintf(writer, "\t\t\t\tif err != nil {\n")
+		fmt.Fprintf(writer, "\t\t\t\t\trows.Close()\n")
+		fmt.Fprintf(writer, "\t\t\t\t\treturn err\n")
+		fmt.Fprintf(writer, "\t\t\t\t}\n")
+		fmt.Fprintf(writer, "\t\t\t}\n")
+		fmt.Fprintf(writer, "\t\t\terr = rows.Err()\n")
+		fmt.Fprintf(writer, "\t\t\trows.Close()\n")
+		fmt.Fprintf(writer, "\t\t\tif err != nil {\n")
+		fmt.Fprintf(writer, "\t\t\t\treturn err\n")
+		fmt.Fprintf(writer, "\t\t\t}\n")
+		fmt.Fprintf(writer, "\t\t\tif count < fetchSize {\n")
+		fmt.Fprintf(writer, "\t\t\t\tbreak\n")
+		fmt.Fprintf(writer, "\t\t\t}\n")
+		fmt.Fprintf(writer, "\t\t}\n")
+		fmt.Fprintf(writer, "\t\t_, err = tx.ExecContext(ctx, %q)\n", "close "+ITERATE_CURSOR_NAME)
+		fmt.Fprintf(writer, "\t\treturn err\n")
+		fmt.Fprintf(writer, "\t})\n")
+		fmt.Fprintf(writer, "}\n\n")
+	}
+}
+
+// writeIterateQuery writes the query of an iteration, the where of the
+// caller is put in parentheses after the condition of variant
+func writeIterateQuery(writer io.Writer, query string, variant *SoftDeleteVariant) {
+	if variant.Condition == "" {
+		fmt.Fprintf(writer, "\tquery := %q\n", query)
+		fmt.Fprintf(writer, "\tif where != \"\" {\n")
+		fmt.Fprintf(writer, "\t\tquery += \" where \" + where\n")
+		fmt.Fprintf(writer, "\t}\n")
+		return
+	}
+	fmt.Fprintf(writer, "\tquery := %q\n", query+" where "+variant.Condition)
+	fmt.Fprintf(writer, "\tif where != \"\" {\n")
+	fmt.Fprintf(writer, "\t\tquery += \" and (\" + where + \")\"\n")
+	fmt.Fprintf(writer, "\t}\n")
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// jsonFileImports returns the packages of the json struct and of its
+// conversions, the json struct refers to the entity and not the opposite so
+// the split layout has no import cycle
+func jsonFileImports(layout *OutputLayout, columns []*Column) []string {
+	seen := make(map[string]bool)
+	result := goTypeImports(columns)
+	for _, importPath := range result {
+		seen[importPath] = true
+	}
+	add := func(importPath string) {
+		if !seen[importPath] {
+			seen[importPath] = true
+			result = append(result, importPath)
+		}
+	}
+	for _, column := range columns {
+		mapping, exists := columnTypeMapping(column)
+		if column.GoType != "" || !exists {
+			continue
+		}
+		if isNullWrapped(column) {
+			add("database/sql")
+		}
+		if mapping.IsTime {
+			add("fmt")
+			add("time")
+		} else if mapping.Import != "" && !mapping.IsArray {
+			add(mapping.Import)
+		}
+	}
+	sort.Strings(result)
+	return append(result, layout.imports(KIND_DTO, KIND_MODEL)...)
+}
+
+// writeJsonConversions writes ToJson and ToEntity, with the split layout the
+// entity lives in another package so ToJson becomes New<Entity>Json
+func writeJsonConversions(writer io.Writer, layout *OutputLayout, names *TableNames, columns []*Column) {
+	entityType := layout.qualifier(KIND_DTO, KIND_MODEL) + names.Entity
+	jsonType := names.Entity + "Json"
+
+	if layout.isSplit() {
+		fmt.Fprintf(writer, "func New%s(e *%s) *%s {\n", jsonType, entityType, jsonType)
+	} else {
+		fmt.Fprintf(writer, "func (e *%s) ToJson() *%s {\n", entityType, jsonType)
+	}
+	fmt.Fprintf(writer, "\tif e == nil {\n")
+	fmt.Fprintf(writer, "\t\treturn nil\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\tj := &%s{}\n", jsonType)
+	for i, column := range columns {
+		if column.OmitFromJson {
+			continue
+		}
+		field := names.Fields[i]
+		mapping, exists := columnTypeMapping(column)
+		switch {
+		case column.GoType != "" || !exists:
+			fmt.Fprintf(writer, "\tj.%s = e.%s\n", field, field)
+		case isNullWrapped(column):
+			fmt.Fprintf(writer, "\tif e.%s.Valid {\n", field)
+			if mapping.IsTime {
+				fmt.Fprintf(writer, "\t\tv := e.%s.Time.Format(time.RFC3339Nano)\n", field)
+			} else if mapping.NullFieldType != "" {
+				fmt.Fprintf(writer, "\t\tv := %s(e.%s.%s)\n", mapping.Type, field, mapping.NullField)
+			} else {
+				fmt.Fprintf(writer, "\t\tv := e.%s.%s\n", field, mapping.NullField)
+			}
+			fmt.Fprintf(writer, "\t\tj.%s = &v\n", field)
+			fmt.Fprintf(writer, "\t}\n")
+		case mapping.IsTime:
+			fmt.Fprintf(writer, "\tj.%s = e.%s.Format(time.RFC3339Nano)\n", field, field)
+		default:
+			fmt.Fprintf(writer, "\tj.%s = e.%s\n", field, field)
+		}
+	}
+	fmt.Fprintf(writer, "\treturn j\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	// the columns left out of the json struct keep their zero value
+	fmt.Fprintf(writer, "func (j *%s) ToEntity() (*%s, error) {\n", jsonType, entityType)
+	fmt.Fprintf(writer, "\tif j == nil {\n")
+	fmt.Fprintf(writer, "\t\treturn nil, nil\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\te := &%s{}\n", entityType)
+	for i, column := range columns {
+		if column.OmitFromJson {
+			continue
+		}
+		field := names.Fields[i]
+		mapping, exists := columnTypeMapping(column)
+		switch {
+		case column.GoType != "" || !exists:
+			fmt.Fprintf(writer, "\te.%s = j.%s\n", field, field)
+		case isNullWrapped(column):
+			fmt.Fprintf(writer, "\tif j.%s != nil {\n", field)
+			if mapping.IsTime {
+				writeTimeParse(writer, "\t\t", "*j."+field, names.JsonNames[i])
+				fmt.Fprintf(writer, "\t\te.%s = %s{Time: t, Valid: true}\n", field, mapping.NullType)
+			} else if mapping.NullFieldType != "" {
+				fmt.Fprintf(writer, "\t\te.%s = %s{%s: %s(*j.%s), Valid: true}\n", field, mapping.NullType, mapping.NullField, mapping.NullFieldType, field)
+			} else {
+				fmt.Fprintf(writer, "\t\te.%s = %s{%s: *j.%s, Valid: true}\n", field, mapping.NullType, mapping.NullField, field)
+			}
+			fmt.Fprintf(writer, "\t}\n")
+		case mapping.IsTime:
+			fmt.Fprintf(writer, "\tif j.%s != \"\" {\n", field)
+			writeTimeParse(writer, "\t\t", "j."+field, names.JsonNames[i])
+			fmt.Fprintf(writer, "\t\te.%s = t\n", field)
+			fmt.Fprintf(writer, "\t}\n")
+		default:
+			fmt.Fprintf(writer, "\te.%s = j.%s\n", field, field)
+		}
+	}
+	fmt.Fprintf(writer, "\treturn e, nil\n")
+	fmt.Fprintf(writer, "}\n\n")
+}
+
+func writeTimeParse(writer io.Writer, indent string, value string, jsonName string) {
+	fmt.Fprintf(writer, "%st, err := time.Parse(time.RFC3339Nano, %s)\n", indent, value)
+	fmt.Fprintf(writer, "%sif err != nil {\n", indent)
+	fmt.Fprintf(writer, "%s\treturn nil, fmt.Errorf(\"invalid %%s : %%w\", %q, err)\n", indent, jsonName)
+	fmt.Fprintf(writer, "%s}\n", indent)
+}
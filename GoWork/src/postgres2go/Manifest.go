@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const MANIFEST_FILE_NAME = ".postgres2go-manifest.json"
+const MANIFEST_VERSION = 1
+
+// Manifest lists the files written by the last generation, relative to the
+// output directory, so files no longer produced can be pruned without ever
+// touching files the tool did not create
+type Manifest struct {
+	Version   int      `json:"version"`
+	Files     []string `json:"files"`
+	directory string
+}
+
+func NewManifest(directory_ string, fileNames []string) *Manifest {
+	manifest := &Manifest{Version: MANIFEST_VERSION, Files: make([]string, 0, len(fileNames)), directory: directory_}
+	seen := make(map[string]bool)
+	for _, fileName := range fileNames {
+		relative, err := filepath.Rel(directory_, fileName)
+		if err != nil || !isSafeManifestEntry(filepath.ToSlash(relative)) {
+			continue
+		}
+		relative = filepath.ToSlash(relative)
+		if !seen[relative] {
+			seen[relative] = true
+			manifest.Files = append(manifest.Files, relative)
+		}
+	}
+	sort.Strings(manifest.Files)
+	return manifest
+}
+
+func manifestFileName(directory string) string {
+	return filepath.Join(directory, MANIFEST_FILE_NAME)
+}
+
+// readManifest returns an empty manifest when the directory has never been generated
+func readManifest(directory string) (*Manifest, error) {
+	content, err := ioutil.ReadFile(manifestFileName(directory))
+	if os.IsNotExist(err) {
+		return NewManifest(directory, nil), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	manifest := new(Manifest)
+	err = json.Unmarshal(content, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("can not parse manifest %s : %s", manifestFileName(directory), err.Error())
+	}
+	if manifest.Version != MANIFEST_VERSION {
+		return nil, fmt.Errorf("unsupported manifest version %d in %s", manifest.Version, manifestFileName(directory))
+	}
+	manifest.directory = directory
+	return manifest, nil
+}
+
+func (manifest *Manifest) write() error {
+	content, err := json.MarshalIndent(manifest, "", "\t")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(manifest.directory, 0755)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(manifestFileName(manifest.directory), append(content, '\n'), 0644)
+}
+
+// an entry must stay inside the output directory, a hand edited manifest can not delete anything else
+func isSafeManifestEntry(relative string) bool {
+	if relative == "" || relative == "." || strings.HasPrefix(relative, "/") || relative == MANIFEST_FILE_NAME {
+		return false
+	}
+	for _, part := range strings.Split(relative, "/") {
+		if part == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+func (manifest *Manifest) fileNames() []string {
+	result := make([]string, 0, len(manifest.Files))
+	for _, relative := range manifest.Files {
+		if isSafeManifestEntry(relative) {
+			result = append(result, filepath.Join(manifest.directory, filepath.FromSlash(relative)))
+		}
+	}
+	return result
+}
+
+// staleFiles returns the files of the previous manifest that the current generation no longer produces
+func (manifest *Manifest) staleFiles(current *Manifest) []string {
+	produced := make(map[string]bool)
+	for _, fileName := range current.fileNames() {
+		produced[fileName] = true
+	}
+	result := make([]string, 0, 0)
+	for _, fileName := range manifest.fileNames() {
+		if !produced[fileName] {
+			result = append(result, fileName)
+		}
+	}
+	return result
+}
@@ -0,0 +1,333 @@
+package main
+
+import (
+	"errors"
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// the initialisms golint expects in upper case
+var DEFAULT_INITIALISMS = []string{
+	"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP",
+	"HTTPS", "ID", "IP", "JSON", "LHS", "QPS", "RAM", "RHS", "RPC", "SLA",
+	"SMTP", "SQL", "SSH", "TCP", "TLS", "TTL", "UDP", "UI", "UID", "UUID",
+	"URI", "URL", "UTF8", "VM", "XML", "XMPP", "XSRF", "XSS",
+}
+
+// names the generated code declares next to the column parameters and variables
+var GENERATOR_RESERVED_NAMES = []string{"context", "ctx", "db", "err", "fmt", "fn", "mock", "pq", "q", "repository", "result", "row", "rows", "sql", "strconv", "sync", "tx"}
+
+// types and files shared by the tables, an entity can not take their name
+var SHARED_GENERATED_NAMES = []string{"ConstraintError", "DBTX", "ErrStaleEntity", DAO_SUPPORT_FILE_NAME, FAKE_DATABASE_FILE_NAME}
+
+// suffixes of the exported names generated per entity, ex UserRepository,
+// the column constants of the query builders can not take them
+var ENTITY_GENERATED_SUFFIXES = []string{"", "Json", "Repository", "SqlRepository", "RepositoryMock", "FakeRepository", "Column", "Predicate", "Order", "Query", "QueryBuilder"}
+
+// suffix of the type of a column constant, ex UserAgeColumn of UserAge
+const COLUMN_TYPE_SUFFIX = "Column"
+
+// methods of the generated entity, a field can not share their name
+var ENTITY_METHOD_NAMES = []string{"String"}
+
+type NamingConfig struct {
+	Initialisms       []string          `json:"initialisms,omitempty"`
+	IgnoreInitialisms []string          `json:"ignoreInitialisms,omitempty"`
+	Singulars         map[string]string `json:"singulars,omitempty"`
+	KeepPluralNames   bool              `json:"keepPluralNames,omitempty"`
+}
+
+// TableNames holds the go names of a table, the column slices follow table.goColumns()
+type TableNames struct {
+	Entity    string
+	Local     string
+	Fields    []string
+	Params    []string
+	JsonNames []string
+}
+
+// Naming turns sql identifiers into go identifiers, names are assigned once
+// per table so every generator agrees on them
+type Naming struct {
+	initialisms map[string]bool
+	config      NamingConfig
+	entities    map[string]string
+	tables      map[string]*TableNames
+	constants   map[string][]string
+}
+
+func NewNaming(config NamingConfig) (*Naming, error) {
+	naming := &Naming{initialisms: make(map[string]bool), config: config, entities: make(map[string]string), tables: make(map[string]*TableNames), constants: make(map[string][]string)}
+	for _, initialism := range DEFAULT_INITIALISMS {
+		naming.initialisms[initialism] = true
+	}
+	for _, initialism := range config.Initialisms {
+		if !isInitialism(initialism) {
+			return nil, errors.New("invalid initialism [" + initialism + "], expected letters and digits")
+		}
+		naming.initialisms[strings.ToUpper(initialism)] = true
+	}
+	for _, initialism := range config.IgnoreInitialisms {
+		delete(naming.initialisms, strings.ToUpper(initialism))
+	}
+	return naming, nil
+}
+
+func isInitialism(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitWords cuts on every rune that is neither a letter nor a digit and on
+// camel case boundaries, ex user_accountId --> user account Id, HTTPServer --> HTTP Server
+func splitWords(s string) []string {
+	words := make([]string, 0, 0)
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		runes := []rune(part)
+		start := 0
+		for i := 1; i < len(runes); i++ {
+			lowerToUpper := unicode.IsLower(runes[i-1]) && unicode.IsUpper(runes[i])
+			acronymEnd := unicode.IsUpper(runes[i-1]) && unicode.IsUpper(runes[i]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if lowerToUpper || acronymEnd {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
+		}
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+func capitalize(word string) string {
+	runes := []rune(strings.ToLower(word))
+	// ToUpper and not ToTitle, a title case digraph is not an exported identifier
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+// goWords returns the words of the exported name, the first word always
+// starts with an upper case letter
+func (naming *Naming) goWords(sqlName string) []string {
+	words := splitWords(sqlName)
+	for i, word := range words {
+		if naming.initialisms[strings.ToUpper(word)] {
+			words[i] = strings.ToUpper(word)
+		} else {
+			words[i] = capitalize(word)
+		}
+	}
+	if len(words) == 0 || !unicode.IsUpper([]rune(words[0])[0]) {
+		// leading digit or a letter without case
+		words = append([]string{"X"}, words...)
+	}
+	return words
+}
+
+// goName returns the exported go name, ex user_id --> UserID
+func (naming *Naming) goName(sqlName string) string {
+	return strings.Join(naming.goWords(sqlName), "")
+}
+
+// localName returns the unexported go name, ex api_url --> apiURL, type --> type_
+func (naming *Naming) localName(sqlName string) string {
+	words := naming.goWords(sqlName)
+	words[0] = strings.ToLower(words[0])
+	return escapeLocalName(strings.Join(words, ""))
+}
+
+// jsonName keeps the lower camel case of the sql name, initialisms would
+// change the wire format
+func jsonName(sqlName string) string {
+	words := splitWords(sqlName)
+	if len(words) == 0 {
+		return sqlName
+	}
+	for i := range words {
+		if i == 0 {
+			words[i] = strings.ToLower(words[i])
+		} else {
+			words[i] = capitalize(words[i])
+		}
+	}
+	return strings.Join(words, "")
+}
+
+func escapeLocalName(name string) string {
+	if token.IsKeyword(name) || types.Universe.Lookup(name) != nil {
+		return name + "_"
+	}
+	for _, reserved := range GENERATOR_RESERVED_NAMES {
+		if name == reserved {
+			return name + "_"
+		}
+	}
+	return name
+}
+
+// uniqueNames suffixes 2, 3, ... to the names already taken, in order, so
+// the result only depends on the column order. A name comes with the names
+// of companions, ex the type UserAgeColumn of the constant UserAge, and is
+// taken when one of them is
+func uniqueNames(names []string, reserved []string, companions ...string) []string {
+	taken := make(map[string]bool)
+	for _, name := range reserved {
+		taken[name] = true
+	}
+	companions = append([]string{""}, companions...)
+	isTaken := func(name string) bool {
+		for _, companion := range companions {
+			if taken[name+companion] {
+				return true
+			}
+		}
+		return false
+	}
+	result := make([]string, len(names))
+	for i, name := range names {
+		unique := name
+		for suffix := 2; isTaken(unique); suffix++ {
+			unique = name + strconv.Itoa(suffix)
+		}
+		for _, companion := range companions {
+			taken[unique+companion] = true
+		}
+		result[i] = unique
+	}
+	return result
+}
+
+// entitySqlName is the table name the entity is named after, the sql keeps
+// the real table name
+func (naming *Naming) entitySqlName(tableName string) string {
+	if naming.config.KeepPluralNames {
+		return tableName
+	}
+	return singularTableName(tableName, naming.config.Singulars)
+}
+
+// assignEntityNames names the entities of all the tables, two tables mapping
+// to the same go name are told apart in table name order
+func (naming *Naming) assignEntityNames(tables []*Table) {
+	tableNames := make([]string, 0, len(tables))
+	for _, table := range tables {
+		tableNames = append(tableNames, table.Name)
+	}
+	sort.Strings(tableNames)
+	goNames := make(map[string]string)
+	for _, table := range tables {
+		goNames[table.Name] = table.GoName
+	}
+	names := make([]string, 0, len(tableNames))
+	for _, tableName := range tableNames {
+		if goNames[tableName] != "" {
+			names = append(names, goNames[tableName])
+		} else {
+			names = append(names, naming.goName(naming.entitySqlName(tableName)))
+		}
+	}
+	naming.entities = make(map[string]string)
+	naming.tables = make(map[string]*TableNames)
+	for i, unique := range uniqueNames(names, SHARED_GENERATED_NAMES) {
+		naming.entities[tableNames[i]] = unique
+	}
+
+	// the column constants of every table share one package, ex user.account_id
+	// and user_account.id are both UserAccountID
+	reserved := append([]string{}, SHARED_GENERATED_NAMES...)
+	for _, entity := range naming.entities {
+		for _, suffix := range ENTITY_GENERATED_SUFFIXES {
+			reserved = append(reserved, entity+suffix, "New"+entity+suffix)
+		}
+	}
+	tablesByName := make(map[string]*Table)
+	for _, table := range tables {
+		tablesByName[table.Name] = table
+	}
+	naming.constants = make(map[string][]string)
+	for _, tableName := range tableNames {
+		constants := naming.columnConstants(tablesByName[tableName])
+		naming.constants[tableName] = uniqueNames(constants, reserved, COLUMN_TYPE_SUFFIX)
+		for _, constant := range naming.constants[tableName] {
+			reserved = append(reserved, constant, constant+COLUMN_TYPE_SUFFIX)
+		}
+	}
+}
+
+// columnConstants returns the names of the column constants of the query
+// builder of table, ex UserEmail of type UserEmailColumn, they follow
+// table.goColumns()
+func (naming *Naming) columnConstants(table *Table) []string {
+	if constants, exists := naming.constants[table.Name]; exists {
+		return constants
+	}
+	names := naming.tableNames(table)
+	result := make([]string, 0, len(names.Fields))
+	for _, field := range names.Fields {
+		result = append(result, names.Entity+field)
+	}
+	return result
+}
+
+func (naming *Naming) entityName(table *Table) string {
+	if name, exists := naming.entities[table.Name]; exists {
+		return name
+	}
+	if table.GoName != "" {
+		return table.GoName
+	}
+	return naming.goName(naming.entitySqlName(table.Name))
+}
+
+func (naming *Naming) tableNames(table *Table) *TableNames {
+	if names, exists := naming.tables[table.Name]; exists {
+		return names
+	}
+	entity := naming.entityName(table)
+	columns := table.goColumns()
+	fields := make([]string, 0, len(columns))
+	jsonNames := make([]string, 0, len(columns))
+	params := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if column.GoName != "" {
+			fields = append(fields, column.GoName)
+			params = append(params, naming.localName(column.GoName))
+		} else {
+			fields = append(fields, naming.goName(column.Name))
+			params = append(params, naming.localName(column.Name))
+		}
+		if column.JsonName != "" {
+			jsonNames = append(jsonNames, column.JsonName)
+		} else {
+			jsonNames = append(jsonNames, jsonName(column.Name))
+		}
+	}
+	fields = uniqueNames(fields, ENTITY_METHOD_NAMES)
+	local := naming.localName(entity)
+	if table.GoName == "" {
+		// keep the de-duplication suffix of the entity name
+		sqlName := naming.entitySqlName(table.Name)
+		local = naming.localName(sqlName) + strings.TrimPrefix(entity, naming.goName(sqlName))
+	}
+	names := &TableNames{
+		Entity:    entity,
+		Local:     local,
+		Fields:    fields,
+		Params:    uniqueNames(params, []string{local}),
+		JsonNames: uniqueNames(jsonNames, nil),
+	}
+	naming.tables[table.Name] = names
+	return names
+}
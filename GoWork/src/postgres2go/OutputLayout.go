@@ -0,0 +1,168 @@
+package main
+
+import (
+	"errors"
+	"go/token"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	KIND_MODEL = "model"
+	KIND_DTO   = "dto"
+	KIND_DAO   = "dao"
+)
+
+const (
+	LAYOUT_FLAT  = "flat"
+	LAYOUT_SPLIT = "split"
+)
+
+const (
+	FILE_NAMING_CAMEL = "camel"
+	FILE_NAMING_SNAKE = "snake"
+)
+
+type OutputConfig struct {
+	Directory    string       `json:"directory,omitempty"`
+	PackageName  string       `json:"packageName,omitempty"`
+	Layout       string       `json:"layout,omitempty"`
+	ImportPath   string       `json:"importPath,omitempty"`
+	FileNaming   string       `json:"fileNaming,omitempty"`
+	ModelPackage string       `json:"modelPackage,omitempty"`
+	DtoPackage   string       `json:"dtoPackage,omitempty"`
+	DaoPackage   string       `json:"daoPackage,omitempty"`
+	UserCode     bool         `json:"userCode,omitempty"`
+	Naming       NamingConfig `json:"naming,omitempty"`
+}
+
+// OutputLayout decides in which directory, package and file each generated
+// kind lands, and how one kind refers to the types of another
+type OutputLayout struct {
+	config OutputConfig
+	naming *Naming
+}
+
+func NewOutputLayout(config_ OutputConfig) (*OutputLayout, error) {
+	config := config_
+	if config.Directory == "" {
+		config.Directory = "./outputs"
+	}
+	if config.PackageName == "" {
+		config.PackageName = "main"
+	}
+	if config.Layout == "" {
+		config.Layout = LAYOUT_FLAT
+	}
+	if config.FileNaming == "" {
+		config.FileNaming = FILE_NAMING_CAMEL
+	}
+	if config.ModelPackage == "" {
+		config.ModelPackage = KIND_MODEL
+	}
+	if config.DtoPackage == "" {
+		config.DtoPackage = KIND_DTO
+	}
+	if config.DaoPackage == "" {
+		config.DaoPackage = KIND_DAO
+	}
+	config.ImportPath = strings.TrimSuffix(config.ImportPath, "/")
+
+	if config.Layout != LAYOUT_FLAT && config.Layout != LAYOUT_SPLIT {
+		return nil, errors.New("invalid output layout [" + config.Layout + "], expected " + LAYOUT_FLAT + " or " + LAYOUT_SPLIT)
+	}
+	if config.FileNaming != FILE_NAMING_CAMEL && config.FileNaming != FILE_NAMING_SNAKE {
+		return nil, errors.New("invalid file naming [" + config.FileNaming + "], expected " + FILE_NAMING_CAMEL + " or " + FILE_NAMING_SNAKE)
+	}
+	for _, packageName := range []string{config.PackageName, config.ModelPackage, config.DtoPackage, config.DaoPackage} {
+		if !token.IsIdentifier(packageName) || token.IsKeyword(packageName) {
+			return nil, errors.New("invalid go package name [" + packageName + "]")
+		}
+	}
+	if config.Layout == LAYOUT_SPLIT && config.ImportPath == "" {
+		return nil, errors.New("output importPath is required with the " + LAYOUT_SPLIT + " layout")
+	}
+	naming, err := NewNaming(config.Naming)
+	if err != nil {
+		return nil, err
+	}
+	return &OutputLayout{config: config, naming: naming}, nil
+}
+
+func (layout *OutputLayout) isSplit() bool {
+	return layout.config.Layout == LAYOUT_SPLIT
+}
+
+func (layout *OutputLayout) outputDirectory() string {
+	return layout.config.Directory
+}
+
+func (layout *OutputLayout) subDirectory(kind string) string {
+	if !layout.isSplit() {
+		return ""
+	}
+	return layout.packageName(kind)
+}
+
+func (layout *OutputLayout) packageName(kind string) string {
+	if !layout.isSplit() {
+		return layout.config.PackageName
+	}
+	switch kind {
+	case KIND_MODEL:
+		return layout.config.ModelPackage
+	case KIND_DTO:
+		return layout.config.DtoPackage
+	case KIND_DAO:
+		return layout.config.DaoPackage
+	}
+	return layout.config.PackageName
+}
+
+func (layout *OutputLayout) directory(kind string) string {
+	return filepath.Join(layout.config.Directory, layout.subDirectory(kind))
+}
+
+func (layout *OutputLayout) importPath(kind string) string {
+	return path.Join(layout.config.ImportPath, layout.subDirectory(kind))
+}
+
+// baseName is the camel file name without extension, ex UserAccountDAO
+func (layout *OutputLayout) fileName(kind string, baseName string) string {
+	if layout.config.FileNaming == FILE_NAMING_SNAKE {
+		baseName = camelToSnake(baseName)
+	}
+	return filepath.Join(layout.directory(kind), baseName+".go")
+}
+
+// qualifier returns the prefix to use in kind fromKind to refer to a type of kind toKind
+func (layout *OutputLayout) qualifier(fromKind string, toKind string) string {
+	if !layout.isSplit() || fromKind == toKind {
+		return ""
+	}
+	return layout.packageName(toKind) + "."
+}
+
+// imports returns the import paths needed by kind fromKind to refer to kinds toKinds
+func (layout *OutputLayout) imports(fromKind string, toKinds ...string) []string {
+	result := make([]string, 0, 0)
+	if !layout.isSplit() {
+		return result
+	}
+	for _, toKind := range toKinds {
+		if toKind != fromKind {
+			result = append(result, layout.importPath(toKind))
+		}
+	}
+	return result
+}
+
+// funcName exports the generated functions meant for callers when they live
+// in their own package, load<Entity>ByID becomes Load<Entity>ByID
+func (layout *OutputLayout) funcName(name string) string {
+	if !layout.isSplit() {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
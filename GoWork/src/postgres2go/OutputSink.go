@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const (
+	FILE_CREATE    = "create"
+	FILE_CHANGE    = "change"
+	FILE_UNCHANGED = "unchanged"
+	FILE_DELETE    = "delete"
+)
+
+type FileChange struct {
+	FileName   string
+	Action     string
+	OldContent string
+	NewContent string
+}
+
+// OutputSink receives the content of every generated file
+type OutputSink interface {
+	writeFile(fileName string, content []byte) error
+}
+
+// FileSink writes generated files to disk, files whose content did not change are not touched
+type FileSink struct {
+	changes []*FileChange
+}
+
+func (sink *FileSink) fileNames() []string {
+	result := make([]string, 0, len(sink.changes))
+	for _, change := range sink.changes {
+		result = append(result, change.FileName)
+	}
+	return result
+}
+
+func NewFileSink() *FileSink {
+	return &FileSink{changes: make([]*FileChange, 0, 0)}
+}
+
+func (sink *FileSink) writeFile(fileName string, content []byte) error {
+	change, err := diskChange(fileName, content)
+	if err != nil {
+		return err
+	}
+	sink.changes = append(sink.changes, change)
+	if change.Action == FILE_UNCHANGED {
+		return nil
+	}
+	err = os.MkdirAll(filepath.Dir(fileName), 0755)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fileName, []byte(change.NewContent), 0644)
+}
+
+// MemorySink keeps generated files in memory, in generation order
+type MemorySink struct {
+	fileNames []string
+	files     map[string][]byte
+}
+
+func NewMemorySink() *MemorySink {
+	return &MemorySink{fileNames: make([]string, 0, 0), files: make(map[string][]byte)}
+}
+
+func (sink *MemorySink) writeFile(fileName string, content []byte) error {
+	if _, exists := sink.files[fileName]; !exists {
+		sink.fileNames = append(sink.fileNames, fileName)
+	}
+	sink.files[fileName] = append([]byte(nil), content...)
+	return nil
+}
+
+// changes compares the files kept in memory with the ones on disk
+func (sink *MemorySink) changes() ([]*FileChange, error) {
+	result := make([]*FileChange, 0, len(sink.fileNames))
+	for _, fileName := range sink.fileNames {
+		change, err := diskChange(fileName, sink.files[fileName])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, change)
+	}
+	return result, nil
+}
+
+func diskChange(fileName string, generated []byte) (*FileChange, error) {
+	content, err := mergeUserCode(fileName, generated)
+	if err != nil {
+		return nil, err
+	}
+	change := &FileChange{FileName: fileName, NewContent: string(content)}
+	current, err := ioutil.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		change.Action = FILE_CREATE
+		return change, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	change.OldContent = string(current)
+	if bytes.Equal(current, content) {
+		change.Action = FILE_UNCHANGED
+	} else {
+		change.Action = FILE_CHANGE
+	}
+	return change, nil
+}
+
+// pruneChanges returns the files of the previous generation that are no longer produced
+func pruneChanges(directory string, generatedFileNames []string) ([]*FileChange, error) {
+	previous, err := readManifest(directory)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*FileChange, 0, 0)
+	for _, fileName := range previous.staleFiles(NewManifest(directory, generatedFileNames)) {
+		current, err := ioutil.ReadFile(fileName)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !isGeneratedContent(string(current)) {
+			// no longer carries our header, someone took it over
+			continue
+		}
+		result = append(result, &FileChange{FileName: fileName, Action: FILE_DELETE, OldContent: string(current)})
+	}
+	return result, nil
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strings"
+)
+
+type ColumnOverride struct {
+	GoName       string `json:"goName,omitempty"`
+	JsonName     string `json:"jsonName,omitempty"`
+	Omit         bool   `json:"omit,omitempty"`
+	OmitFromJson bool   `json:"omitFromJson,omitempty"`
+	ReadOnly     bool   `json:"readOnly,omitempty"`
+	GoType       string `json:"goType,omitempty"`
+}
+
+type TableOverride struct {
+	GoName           string                    `json:"goName,omitempty"`
+	VersionColumn    string                    `json:"versionColumn,omitempty"`
+	SoftDeleteColumn string                    `json:"softDeleteColumn,omitempty"`
+	CreatedAtColumn  string                    `json:"createdAtColumn,omitempty"`
+	UpdatedAtColumn  string                    `json:"updatedAtColumn,omitempty"`
+	Columns          map[string]ColumnOverride `json:"columns,omitempty"`
+}
+
+// validate checks what does not depend on the schema, config validate runs it
+// without a database
+func (override TableOverride) validate() error {
+	if override.GoName != "" && (!token.IsIdentifier(override.GoName) || !token.IsExported(override.GoName)) {
+		return errors.New("goName [" + override.GoName + "] is not an exported go identifier")
+	}
+	columnNames := make([]string, 0, len(override.Columns))
+	for columnName := range override.Columns {
+		columnNames = append(columnNames, columnName)
+	}
+	sort.Strings(columnNames)
+	for _, columnName := range columnNames {
+		column := override.Columns[columnName]
+		if column.GoName != "" && (!token.IsIdentifier(column.GoName) || !token.IsExported(column.GoName)) {
+			return errors.New("column [" + columnName + "] : goName [" + column.GoName + "] is not an exported go identifier")
+		}
+		if column.GoType != "" {
+			_, _, err := parseGoType(column.GoType)
+			if err != nil {
+				return errors.New("column [" + columnName + "] : " + err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func sortedOverrideNames(overrides map[string]TableOverride) []string {
+	result := make([]string, 0, len(overrides))
+	for tableName := range overrides {
+		result = append(result, tableName)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// applyTableOverrides copies the overrides of the config onto the schema, a
+// table or column that does not exist is an error so a typo is not ignored
+func applyTableOverrides(schema *Schema, overrides map[string]TableOverride) error {
+	tables := make(map[string]*Table)
+	for _, table := range schema.Tables {
+		tables[table.Name] = table
+	}
+	for _, tableName := range sortedOverrideNames(overrides) {
+		override := overrides[tableName]
+		table, exists := tables[tableName]
+		if !exists {
+			return errors.New("tables override [" + tableName + "] : no such table")
+		}
+		err := override.validate()
+		if err != nil {
+			return fmt.Errorf("tables override [%s] : %s", tableName, err.Error())
+		}
+		if override.GoName != "" {
+			table.GoName = override.GoName
+		}
+		err = applyColumnOverrides(table, override.Columns)
+		if err != nil {
+			return fmt.Errorf("tables override [%s] : %s", tableName, err.Error())
+		}
+		if override.VersionColumn != "" {
+			table.VersionColumn = override.VersionColumn
+			column := table.versionColumn()
+			if column == nil || !isVersionColumn(column) {
+				return fmt.Errorf("tables override [%s] : versionColumn [%s] is not a NOT NULL integer or timestamp column", tableName, override.VersionColumn)
+			}
+		}
+		if override.SoftDeleteColumn != "" {
+			table.SoftDeleteColumn = override.SoftDeleteColumn
+			column := table.softDeleteColumn()
+			if column == nil || !isSoftDeleteColumn(column) {
+				return fmt.Errorf("tables override [%s] : softDeleteColumn [%s] is not a nullable timestamp column", tableName, override.SoftDeleteColumn)
+			}
+		}
+		if override.CreatedAtColumn != "" {
+			table.CreatedAtColumn = override.CreatedAtColumn
+			column := table.createdAtColumn()
+			if column == nil || !isAuditColumn(column) {
+				return fmt.Errorf("tables override [%s] : createdAtColumn [%s] is not a timestamp column", tableName, override.CreatedAtColumn)
+			}
+		}
+		if override.UpdatedAtColumn != "" {
+			table.UpdatedAtColumn = override.UpdatedAtColumn
+			column := table.updatedAtColumn()
+			if column == nil || !isAuditColumn(column) {
+				return fmt.Errorf("tables override [%s] : updatedAtColumn [%s] is not a timestamp column", tableName, override.UpdatedAtColumn)
+			}
+		}
+	}
+	return nil
+}
+
+func applyColumnOverrides(table *Table, overrides map[string]ColumnOverride) error {
+	columns := make(map[string]*Column)
+	for _, column := range table.columns {
+		columns[column.Name] = column
+	}
+	columnNames := make([]string, 0, len(overrides))
+	for columnName := range overrides {
+		columnNames = append(columnNames, columnName)
+	}
+	sort.Strings(columnNames)
+	for _, columnName := range columnNames {
+		override := overrides[columnName]
+		column, exists := columns[columnName]
+		if !exists {
+			return errors.New("column [" + columnName + "] : no such column")
+		}
+		if override.Omit && column.IsPrimary {
+			return errors.New("column [" + columnName + "] : the primary key can not be omitted")
+		}
+		column.GoName = override.GoName
+		column.JsonName = override.JsonName
+		column.Omit = override.Omit
+		column.OmitFromJson = override.OmitFromJson
+		column.ReadOnly = override.ReadOnly
+		column.GoType = override.GoType
+	}
+	return nil
+}
+
+// parseGoType splits a type of the config into the go expression and the
+// package to import, ex *github.com/shopspring/decimal.Decimal -->
+// *decimal.Decimal and github.com/shopspring/decimal. database/sql scans the
+// column into the type and writes it back as a parameter, so it must either
+// implement sql.Scanner and driver.Valuer or be one database/sql converts
+// natively : a string, number or bool type, []byte, time.Time or a pointer to
+// one. Only a slice or a map is rejected here, ex []string needs pq.StringArray
+func parseGoType(goType string) (string, string, error) {
+	prefix := ""
+	rest := strings.TrimSpace(goType)
+	for strings.HasPrefix(rest, "*") || strings.HasPrefix(rest, "[]") {
+		if rest[0] == '*' {
+			prefix += "*"
+			rest = rest[1:]
+		} else {
+			prefix += "[]"
+			rest = rest[2:]
+		}
+	}
+	expression := prefix + rest
+	importPath := ""
+	lastDot := strings.LastIndex(rest, ".")
+	if lastDot > 0 && !strings.ContainsAny(rest, "[]() ") {
+		importPath = rest[:lastDot]
+		expression = prefix + path.Base(importPath) + rest[lastDot:]
+	}
+	if _, err := parser.ParseExpr(expression); err != nil || rest == "" {
+		return "", "", errors.New("invalid goType [" + goType + "]")
+	}
+	if strings.Contains(prefix, "[]") && expression != "[]byte" || strings.HasPrefix(rest, "map[") {
+		return "", "", errors.New("goType [" + goType + "] is neither a sql.Scanner nor a type database/sql converts, ex []string needs github.com/lib/pq.StringArray")
+	}
+	return expression, importPath, nil
+}
+
+// goTypeImports returns the packages the goType overrides of columns need,
+// sorted and without the ones the file already imports
+func goTypeImports(columns []*Column, imported ...string) []string {
+	seen := make(map[string]bool)
+	for _, importPath := range imported {
+		seen[importPath] = true
+	}
+	result := make([]string, 0, 0)
+	for _, column := range columns {
+		if column.GoType == "" {
+			continue
+		}
+		_, importPath, _ := parseGoType(column.GoType)
+		if importPath != "" && !seen[importPath] {
+			seen[importPath] = true
+			result = append(result, importPath)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
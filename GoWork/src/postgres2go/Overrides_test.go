@@ -0,0 +1,49 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseGoType(t *testing.T) {
+	tests := []struct {
+		goType     string
+		expression string
+		importPath string
+	}{
+		{"string", "string", ""},
+		{"[]byte", "[]byte", ""},
+		{"*github.com/shopspring/decimal.Decimal", "*decimal.Decimal", "github.com/shopspring/decimal"},
+		{"github.com/lib/pq.StringArray", "pq.StringArray", "github.com/lib/pq"},
+		{"database/sql.NullString", "sql.NullString", "database/sql"},
+	}
+	for _, test := range tests {
+		expression, importPath, err := parseGoType(test.goType)
+		if err != nil {
+			t.Errorf("%s : %s", test.goType, err)
+		} else if expression != test.expression || importPath != test.importPath {
+			t.Errorf("%s : %s and %s, expected %s and %s", test.goType, expression, importPath, test.expression, test.importPath)
+		}
+	}
+}
+
+func TestParseGoTypeErrors(t *testing.T) {
+	tests := []struct {
+		goType   string
+		expected string
+	}{
+		{"", "invalid goType"},
+		{"func(", "invalid goType"},
+		{"[]string", "is neither a sql.Scanner nor a type database/sql converts"},
+		{"*[]int64", "is neither a sql.Scanner nor a type database/sql converts"},
+		{"map[string]string", "is neither a sql.Scanner nor a type database/sql converts"},
+	}
+	for _, test := range tests {
+		_, _, err := parseGoType(test.goType)
+		if err == nil {
+			t.Errorf("%q : no error, expected %s", test.goType, test.expected)
+		} else if !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("%q : error %q, expected %s", test.goType, err.Error(), test.expected)
+		}
+	}
+}
@@ -1,18 +1,69 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 )
 
 type PostgresToGoConfig struct {
-	Login      string `json:"login,omitempty"`
-	Password   string `json:"password,omitempty"`
-	Host       string `json:"host,omitempty"`
-	Port       int64  `json:"port,omitempty"`
-	Parameters string `json:"parameters,omitempty"`
-	Db         string `json:"db,omitempty"`
+	Login      string                   `json:"login,omitempty"`
+	Password   string                   `json:"password,omitempty"`
+	Host       string                   `json:"host,omitempty"`
+	Port       int64                    `json:"port,omitempty"`
+	Parameters string                   `json:"parameters,omitempty"`
+	Db         string                   `json:"db,omitempty"`
+	Output     OutputConfig             `json:"output,omitempty"`
+	Tables     map[string]TableOverride `json:"tables,omitempty"`
 }
 
-func (config *PostgresToGoConfig) dbPostgresConnectString() string {
-	return fmt.Sprintf("user=%s password=%s host=%s dbname=%s port=%d %s", config.Login, config.Password, config.Host, config.Db, config.Port, config.Parameters)
+func (config *PostgresToGoConfig) validate() error {
+	missing := make([]string, 0, 0)
+	if strings.TrimSpace(config.Login) == "" {
+		missing = append(missing, "login")
+	}
+	if strings.TrimSpace(config.Host) == "" {
+		missing = append(missing, "host")
+	}
+	if strings.TrimSpace(config.Db) == "" {
+		missing = append(missing, "db")
+	}
+	if len(missing) > 0 {
+		return errors.New("missing required config field(s) : " + strings.Join(missing, ", "))
+	}
+	if config.Port < 0 || config.Port > 65535 {
+		return fmt.Errorf("invalid config field port : %d is not in range 1-65535, leave it out or set 0 for the default port", config.Port)
+	}
+	return nil
+}
+
+// libpq key/value syntax : values are single quoted, ' and \ are backslash escaped
+func dsnQuote(value string) string {
+	s := strings.Replace(value, "\\", "\\\\", -1)
+	s = strings.Replace(s, "'", "\\'", -1)
+	return "'" + s + "'"
+}
+
+func (config *PostgresToGoConfig) dbPostgresConnectString() (string, error) {
+	err := config.validate()
+	if err != nil {
+		return "", err
+	}
+	addSecret(config.Password)
+	addSecret(dsnQuote(config.Password))
+
+	parts := make([]string, 0, 6)
+	parts = append(parts, "user="+dsnQuote(config.Login))
+	if config.Password != "" {
+		parts = append(parts, "password="+dsnQuote(config.Password))
+	}
+	parts = append(parts, "host="+dsnQuote(config.Host))
+	parts = append(parts, "dbname="+dsnQuote(config.Db))
+	if config.Port != 0 {
+		parts = append(parts, fmt.Sprintf("port=%d", config.Port))
+	}
+	if strings.TrimSpace(config.Parameters) != "" {
+		parts = append(parts, strings.TrimSpace(config.Parameters))
+	}
+	return strings.Join(parts, " "), nil
 }
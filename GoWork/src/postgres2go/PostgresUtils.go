@@ -1,20 +1,18 @@
 package main
 
 import (
-	"bufio"
 	"database/sql" // package SQL
 	"errors"
-	"fmt"
-	_ "github.com/lib/pq" // driver Postgres
+	"github.com/lib/pq" // driver Postgres
 )
 
-func readTableList(db *sql.DB) []string {
+func readTableList(db *sql.DB) ([]string, error) {
 	result := make([]string, 0, 0)
 
 	sqlTableList := "SELECT c.relname as Name FROM pg_catalog.pg_class c LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace WHERE c.relkind IN ('r','') AND n.nspname <> 'pg_catalog' AND n.nspname <> 'information_schema' AND n.nspname !~ '^pg_toast' AND pg_catalog.pg_table_is_visible(c.oid) ORDER BY 1"
 	rows, err := db.Query(sqlTableList)
 	if err != nil {
-		return result
+		return result, err
 	}
 	defer rows.Close()
 
@@ -22,15 +20,15 @@ func readTableList(db *sql.DB) []string {
 		var tableName string
 		err = rows.Scan(&tableName)
 		if err != nil {
-			return make([]string, 0, 0)
+			return make([]string, 0, 0), err
 		}
 		result = append(result, tableName)
 	}
-	return result
+	return result, nil
 }
 
 func getTableNameOID(db *sql.DB, tableName string) (*Table, error) {
-	sqlTableOID := "SELECT c.oid,c.relname FROM pg_catalog.pg_class c LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace WHERE c.relname=$1 AND pg_catalog.pg_table_is_visible(c.oid) ORDER BY 2"
+	sqlTableOID := "SELECT c.oid,c.relname,COALESCE(pg_catalog.obj_description(c.oid, 'pg_class'), '') FROM pg_catalog.pg_class c LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace WHERE c.relname=$1 AND pg_catalog.pg_table_is_visible(c.oid) ORDER BY 2"
 	rows, err := db.Query(sqlTableOID, tableName)
 	if err != nil {
 		return nil, err
@@ -40,11 +38,14 @@ func getTableNameOID(db *sql.DB, tableName string) (*Table, error) {
 	if rows.Next() {
 		var oid string
 		var name string
-		err = rows.Scan(&oid, &name)
+		var comment string
+		err = rows.Scan(&oid, &name, &comment)
 		if err != nil {
 			return nil, err
 		}
-		return NewTable(oid, name), nil
+		table := NewTable(oid, name)
+		table.Comment = comment
+		return table, nil
 	}
 	return nil, errors.New("No Results")
 }
@@ -74,7 +75,7 @@ func getForeignKeysList(db *sql.DB, table *Table) error {
 
 func getColumnsList(db *sql.DB, table *Table) error {
 	result := make([]*Column, 0, 0)
-	sqlColumns := "SELECT a.attname, pg_catalog.format_type(a.atttypid, a.atttypmod), a.attnotnull FROM pg_catalog.pg_attribute a WHERE a.attrelid=$1 AND a.attnum > 0 AND NOT a.attisdropped ORDER BY a.attnum"
+	sqlColumns := "SELECT a.attname, pg_catalog.format_type(a.atttypid, a.atttypmod), a.attnotnull, COALESCE(pg_catalog.col_description(a.attrelid, a.attnum), '') FROM pg_catalog.pg_attribute a WHERE a.attrelid=$1 AND a.attnum > 0 AND NOT a.attisdropped ORDER BY a.attnum"
 	rows, err := db.Query(sqlColumns, table.Oid)
 	if err != nil {
 		return err
@@ -84,12 +85,14 @@ func getColumnsList(db *sql.DB, table *Table) error {
 	for rows.Next() {
 		var columnName string
 		var formatType string
-		var isnullable bool
-		err = rows.Scan(&columnName, &formatType, &isnullable)
+		var notnull bool
+		var comment string
+		err = rows.Scan(&columnName, &formatType, &notnull, &comment)
 		if err != nil {
 			return err
 		}
-		column := NewColumn(columnName, formatType, isnullable)
+		column := NewColumn(columnName, formatType, !notnull)
+		column.Comment = comment
 		result = append(result, column)
 	}
 	table.columns = result
@@ -118,30 +121,54 @@ func getPrimaryKeyConstraint(db *sql.DB, table *Table) error {
 	return errors.New("No PrimaryKey")
 }
 
-func descTable(logWriter *bufio.Writer, db *sql.DB, tableName string) (*Table, error) {
-	table, err := getTableNameOID(db, tableName)
-	if err == nil {
-		fmt.Fprintf(logWriter, "%s::%s ", table.Oid, table.Name)
-		err := getColumnsList(db, table)
-		if err != nil {
-			return table, err
-		}
-		fmt.Fprintf(logWriter, " columns::%d ", len(table.columns))
+func getIndexesList(db *sql.DB, table *Table) error {
+	result := make([]*Index, 0, 0)
+	sqlIndexes := "SELECT c2.relname, pg_catalog.pg_get_indexdef(i.indexrelid, 0, true), COALESCE(pg_catalog.pg_get_constraintdef(con.oid, true), ''), i.indisprimary, i.indisunique, ARRAY(SELECT a.attname FROM unnest(i.indkey) WITH ORDINALITY k(attnum, n) JOIN pg_catalog.pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = k.attnum ORDER BY k.n) FROM pg_catalog.pg_class c2, pg_catalog.pg_index i LEFT JOIN pg_catalog.pg_constraint con ON (conrelid = i.indrelid AND conindid = i.indexrelid AND contype IN ('p','u','x')) WHERE i.indrelid=$1 AND i.indexrelid = c2.oid ORDER BY i.indisprimary DESC, i.indisunique DESC, c2.relname"
+	rows, err := db.Query(sqlIndexes, table.Oid)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
 
-		err = getPrimaryKeyConstraint(db, table)
+	for rows.Next() {
+		var relname string
+		var definition string
+		var constraint string
+		var isprimary bool
+		var isunique bool
+		var columns []string
+		err = rows.Scan(&relname, &definition, &constraint, &isprimary, &isunique, pq.Array(&columns))
 		if err != nil {
-			fmt.Fprintf(logWriter, " NoPrimaryKey ")
-		} else {
-			fmt.Fprintf(logWriter, " %s::%s ", table.PrimaryKeyName, table.PrimaryKeyConstraint)
+			return err
 		}
+		result = append(result, NewIndex(relname, definition, constraint, isprimary, isunique, columns))
+	}
+	table.indexes = result
+	return nil
+}
+
+func readEnumList(db *sql.DB) ([]*Enum, error) {
+	result := make([]*Enum, 0, 0)
+	sqlEnums := "SELECT t.typname, e.enumlabel FROM pg_catalog.pg_type t JOIN pg_catalog.pg_enum e ON e.enumtypid = t.oid WHERE pg_catalog.pg_type_is_visible(t.oid) ORDER BY t.typname, e.enumsortorder"
+	rows, err := db.Query(sqlEnums)
+	if err != nil {
+		return result, err
+	}
+	defer rows.Close()
 
-		err = getForeignKeysList(db, table)
+	var enum *Enum
+	for rows.Next() {
+		var typname string
+		var label string
+		err = rows.Scan(&typname, &label)
 		if err != nil {
-			fmt.Fprintf(logWriter, " NoForeignKeys\n")
-		} else {
-			fmt.Fprintf(logWriter, " foreignKeys::%d\n", len(table.foreignKeys))
+			return result, err
 		}
-
+		if enum == nil || enum.Name != typname {
+			enum = NewEnum(typname)
+			result = append(result, enum)
+		}
+		enum.Values = append(enum.Values, label)
 	}
-	return table, nil
+	return result, nil
 }
@@ -0,0 +1,318 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"github.com/lib/pq"
+	"io"
+	"sort"
+)
+
+// QueryOperator is a method of the column constants building a predicate on
+// one value of the go type of the column, ex UserAge.Gt(18) --> age > $1
+type QueryOperator struct {
+	Method   string
+	Operator string
+	IsString bool // only on the columns held in a string
+}
+
+var QUERY_OPERATORS = []QueryOperator{
+	{Method: "Eq", Operator: "="},
+	{Method: "Ne", Operator: "<>"},
+	{Method: "Lt", Operator: "<"},
+	{Method: "Le", Operator: "<="},
+	{Method: "Gt", Operator: ">"},
+	{Method: "Ge", Operator: ">="},
+	{Method: "Like", Operator: "like", IsString: true},
+}
+
+// writeQuerySupport writes the rendering the query builders of every table
+// share, the values of the predicates are always numbered parameters
+func writeQuerySupport(writer io.Writer) {
+	fmt.Fprintf(writer, "// queryPredicate is a condition of a query builder, or the disjunction of or\n")
+	fmt.Fprintf(writer, "type queryPredicate struct {\n")
+	fmt.Fprintf(writer, "\tcolumn   string\n")
+	fmt.Fprintf(writer, "\toperator string\n")
+	fmt.Fprintf(writer, "\targs     []interface{}\n")
+	fmt.Fprintf(writer, "\tor       []queryPredicate\n")
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "func (predicate queryPredicate) write(builder *strings.Builder, args []interface{}) []interface{} {\n")
+	fmt.Fprintf(writer, "\tswitch predicate.operator {\n")
+	fmt.Fprintf(writer, "\tcase \"or\":\n")
+	fmt.Fprintf(writer, "\t\tbuilder.WriteString(\"(\")\n")
+	fmt.Fprintf(writer, "\t\tfor i, or := range predicate.or {\n")
+	fmt.Fprintf(writer, "\t\t\tif i > 0 {\n")
+	fmt.Fprintf(writer, "\t\t\t\tbuilder.WriteString(\" or \")\n")
+	fmt.Fprintf(writer, "\t\t\t}\n")
+	fmt.Fprintf(writer, "\t\t\targs = or.write(builder, args)\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t\tbuilder.WriteString(\")\")\n")
+	fmt.Fprintf(writer, "\tcase \"is null\", \"is not null\":\n")
+	fmt.Fprintf(writer, "\t\tbuilder.WriteString(predicate.column + \" \" + predicate.operator)\n")
+	fmt.Fprintf(writer, "\tcase \"in\":\n")
+	fmt.Fprintf(writer, "\t\t// in () is a syntax error, no value matches no row\n")
+	fmt.Fprintf(writer, "\t\tif len(predicate.args) == 0 {\n")
+	fmt.Fprintf(writer, "\t\t\tbuilder.WriteString(\"false\")\n")
+	fmt.Fprintf(writer, "\t\t\treturn args\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t\tbuilder.WriteString(predicate.column + \" in (\")\n")
+	fmt.Fprintf(writer, "\t\tfor i, arg := range predicate.args {\n")
+	fmt.Fprintf(writer, "\t\t\tif i > 0 {\n")
+	fmt.Fprintf(writer, "\t\t\t\tbuilder.WriteString(\",\")\n")
+	fmt.Fprintf(writer, "\t\t\t}\n")
+	fmt.Fprintf(writer, "\t\t\targs = append(args, arg)\n")
+	fmt.Fprintf(writer, "\t\t\tbuilder.WriteString(\"$\" + strconv.Itoa(len(args)))\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t\tbuilder.WriteString(\")\")\n")
+	fmt.Fprintf(writer, "\tdefault:\n")
+	fmt.Fprintf(writer, "\t\targs = append(args, predicate.args...)\n")
+	fmt.Fprintf(writer, "\t\tbuilder.WriteString(predicate.column + \" \" + predicate.operator + \" $\" + strconv.Itoa(len(args)))\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn args\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "type queryOrder struct {\n")
+	fmt.Fprintf(writer, "\tcolumn    string\n")
+	fmt.Fprintf(writer, "\tdirection string\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "// queryBuilder is the state of a query builder, softDelete is the column\n")
+	fmt.Fprintf(writer, "// hiding the soft deleted rows, empty when they are read too\n")
+	fmt.Fprintf(writer, "type queryBuilder struct {\n")
+	fmt.Fprintf(writer, "\ttable      string\n")
+	fmt.Fprintf(writer, "\tsoftDelete string\n")
+	fmt.Fprintf(writer, "\twhere      []queryPredicate\n")
+	fmt.Fprintf(writer, "\torders     []queryOrder\n")
+	fmt.Fprintf(writer, "\tlimit      int\n")
+	fmt.Fprintf(writer, "\toffset     int\n")
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "func (query queryBuilder) build(selection string) (string, []interface{}) {\n")
+	fmt.Fprintf(writer, "\tvar builder strings.Builder\n")
+	fmt.Fprintf(writer, "\targs := make([]interface{}, 0, 0)\n")
+	fmt.Fprintf(writer, "\tbuilder.WriteString(\"select \" + selection + \" from \" + query.table)\n")
+	fmt.Fprintf(writer, "\twhere := query.where\n")
+	fmt.Fprintf(writer, "\tif query.softDelete != \"\" {\n")
+	fmt.Fprintf(writer, "\t\twhere = append([]queryPredicate{{column: query.softDelete, operator: \"is null\"}}, where...)\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\tfor i, predicate := range where {\n")
+	fmt.Fprintf(writer, "\t\tif i == 0 {\n")
+	fmt.Fprintf(writer, "\t\t\tbuilder.WriteString(\" where \")\n")
+	fmt.Fprintf(writer, "\t\t} else {\n")
+	fmt.Fprintf(writer, "\t\t\tbuilder.WriteString(\" and \")\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t\targs = predicate.write(&builder, args)\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\tfor i, order := range query.orders {\n")
+	fmt.Fprintf(writer, "\t\tif i == 0 {\n")
+	fmt.Fprintf(writer, "\t\t\tbuilder.WriteString(\" order by \")\n")
+	fmt.Fprintf(writer, "\t\t} else {\n")
+	fmt.Fprintf(writer, "\t\t\tbuilder.WriteString(\",\")\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t\tbuilder.WriteString(order.column + \" \" + order.direction)\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\tif query.limit > 0 {\n")
+	fmt.Fprintf(writer, "\t\tbuilder.WriteString(\" limit \" + strconv.Itoa(query.limit))\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\tif query.offset > 0 {\n")
+	fmt.Fprintf(writer, "\t\tbuilder.WriteString(\" offset \" + strconv.Itoa(query.offset))\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn builder.String(), args\n")
+	fmt.Fprintf(writer, "}\n\n")
+}
+
+// writeQueryColumn writes the type of the constant of column, the shared
+// methods of columnType and the operators taking values of the go type of
+// the column, ex func (UserAgeColumn) Gt(value int) UserPredicate
+func writeQueryColumn(writer io.Writer, typeName string, columnType string, predicateType string, table *Table, column *Column) {
+	valueType := columnValueType(column)
+	fmt.Fprintf(writer, "// %s is the %s column of %s\n", typeName, column.Name, table.Name)
+	fmt.Fprintf(writer, "type %s struct {\n", typeName)
+	fmt.Fprintf(writer, "\t%s\n", columnType)
+	fmt.Fprintf(writer, "}\n\n")
+	for _, operator := range QUERY_OPERATORS {
+		if operator.IsString && valueType != "string" {
+			continue
+		}
+		fmt.Fprintf(writer, "func (column %s) %s(value %s) %s {\n", typeName, operator.Method, valueType, predicateType)
+		fmt.Fprintf(writer, "\treturn %s{queryPredicate{column: column.name, operator: %q, args: []interface{}{value}}}\n", predicateType, operator.Operator)
+		fmt.Fprintf(writer, "}\n\n")
+	}
+	fmt.Fprintf(writer, "func (column %s) In(values ...%s) %s {\n", typeName, valueType, predicateType)
+	fmt.Fprintf(writer, "\targs := make([]interface{}, 0, len(values))\n")
+	fmt.Fprintf(writer, "\tfor _, value := range values {\n")
+	fmt.Fprintf(writer, "\t\targs = append(args, value)\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn %s{queryPredicate{column: column.name, operator: \"in\", args: args}}\n", predicateType)
+	fmt.Fprintf(writer, "}\n\n")
+}
+
+// generateGoQuery writes the query builder of table : a constant per column,
+// the predicates and orders on them and <Entity>Query() selecting the rows,
+// a column of another table does not compile
+func generateGoQuery(layout *OutputLayout, sink OutputSink, table *Table) error {
+	names := layout.naming.tableNames(table)
+	columns := table.goColumns()
+	constants := layout.naming.columnConstants(table)
+	entityType := layout.qualifier(KIND_DAO, KIND_MODEL) + names.Entity
+	columnType := names.Entity + "Column"
+	predicateType := names.Entity + "Predicate"
+	orderType := names.Entity + "Order"
+	builderType := names.Entity + "QueryBuilder"
+	var buffer bytes.Buffer
+	writer := bufio.NewWriter(&buffer)
+	writeGeneratedHeader(writer, layout, KIND_DAO, table)
+	imports := append(valueImports(columns, "context"), "context")
+	sort.Strings(imports)
+	writeImports(writer, append(imports, layout.imports(KIND_DAO, KIND_MODEL)...))
+	writeUserCodeRegion(writer, layout, USER_CODE_IMPORTS)
+
+	fmt.Fprintf(writer, "// %s is a column of %s, the query builder of %s takes no other\n", columnType, table.Name, table.Name)
+	fmt.Fprintf(writer, "type %s struct {\n", columnType)
+	fmt.Fprintf(writer, "\tname string\n")
+	fmt.Fprintf(writer, "}\n\n")
+	width := 0
+	for _, constant := range constants {
+		if len(constant) > width {
+			width = len(constant)
+		}
+	}
+	fmt.Fprintf(writer, "var (\n")
+	for i, column := range columns {
+		fmt.Fprintf(writer, "\t%-*s = %s{%s{name: %q}}\n", width, constants[i], constants[i]+COLUMN_TYPE_SUFFIX, columnType, pq.QuoteIdentifier(column.Name))
+	}
+	fmt.Fprintf(writer, ")\n\n")
+
+	fmt.Fprintf(writer, "// %s is a condition on the columns of %s, its values are parameters\n", predicateType, table.Name)
+	fmt.Fprintf(writer, "type %s struct {\n", predicateType)
+	fmt.Fprintf(writer, "\tpredicate queryPredicate\n")
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "type %s struct {\n", orderType)
+	fmt.Fprintf(writer, "\torder queryOrder\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "func (column %s) IsNull() %s {\n", columnType, predicateType)
+	fmt.Fprintf(writer, "\treturn %s{queryPredicate{column: column.name, operator: \"is null\"}}\n", predicateType)
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "func (column %s) IsNotNull() %s {\n", columnType, predicateType)
+	fmt.Fprintf(writer, "\treturn %s{queryPredicate{column: column.name, operator: \"is not null\"}}\n", predicateType)
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "func (column %s) Asc() %s {\n", columnType, orderType)
+	fmt.Fprintf(writer, "\treturn %s{queryOrder{column: column.name, direction: \"asc\"}}\n", orderType)
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "func (column %s) Desc() %s {\n", columnType, orderType)
+	fmt.Fprintf(writer, "\treturn %s{queryOrder{column: column.name, direction: \"desc\"}}\n", orderType)
+	fmt.Fprintf(writer, "}\n\n")
+
+	for i, column := range columns {
+		writeQueryColumn(writer, constants[i]+COLUMN_TYPE_SUFFIX, columnType, predicateType, table, column)
+	}
+
+	fmt.Fprintf(writer, "// Or matches the rows matching predicate or one of others\n")
+	fmt.Fprintf(writer, "func (predicate %s) Or(others ...%s) %s {\n", predicateType, predicateType, predicateType)
+	fmt.Fprintf(writer, "\tresult := queryPredicate{operator: \"or\", or: []queryPredicate{predicate.predicate}}\n")
+	fmt.Fprintf(writer, "\tfor _, other := range others {\n")
+	fmt.Fprintf(writer, "\t\tresult.or = append(result.or, other.predicate)\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn %s{result}\n", predicateType)
+	fmt.Fprintf(writer, "}\n\n")
+
+	softDelete := ""
+	if column := table.softDeleteColumn(); column != nil {
+		softDelete = pq.QuoteIdentifier(column.Name)
+	}
+	example := constants[0] + ".Eq(value)"
+	fmt.Fprintf(writer, "// %s selects %s rows, ex %sQuery().Where(%s).Limit(50).All(ctx, db)\n", builderType, table.Name, names.Entity, example)
+	fmt.Fprintf(writer, "type %s struct {\n", builderType)
+	fmt.Fprintf(writer, "\tquery queryBuilder\n")
+	fmt.Fprintf(writer, "}\n\n")
+	if softDelete != "" {
+		fmt.Fprintf(writer, "// %sQuery selects every %s row not soft deleted\n", names.Entity, table.Name)
+	} else {
+		fmt.Fprintf(writer, "// %sQuery selects every %s row\n", names.Entity, table.Name)
+	}
+	fmt.Fprintf(writer, "func %sQuery() *%s {\n", names.Entity, builderType)
+	if softDelete != "" {
+		fmt.Fprintf(writer, "\treturn &%s{query: queryBuilder{table: %q, softDelete: %q}}\n", builderType, pq.QuoteIdentifier(table.Name), softDelete)
+	} else {
+		fmt.Fprintf(writer, "\treturn &%s{query: queryBuilder{table: %q}}\n", builderType, pq.QuoteIdentifier(table.Name))
+	}
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "// Where keeps the rows matching every predicate\n")
+	fmt.Fprintf(writer, "func (builder *%s) Where(predicates ...%s) *%s {\n", builderType, predicateType, builderType)
+	fmt.Fprintf(writer, "\tfor _, predicate := range predicates {\n")
+	fmt.Fprintf(writer, "\t\tbuilder.query.where = append(builder.query.where, predicate.predicate)\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn builder\n")
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "func (builder *%s) OrderBy(orders ...%s) *%s {\n", builderType, orderType, builderType)
+	fmt.Fprintf(writer, "\tfor _, order := range orders {\n")
+	fmt.Fprintf(writer, "\t\tbuilder.query.orders = append(builder.query.orders, order.order)\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn builder\n")
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "func (builder *%s) Limit(limit int) *%s {\n", builderType, builderType)
+	fmt.Fprintf(writer, "\tbuilder.query.limit = limit\n")
+	fmt.Fprintf(writer, "\treturn builder\n")
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "func (builder *%s) Offset(offset int) *%s {\n", builderType, builderType)
+	fmt.Fprintf(writer, "\tbuilder.query.offset = offset\n")
+	fmt.Fprintf(writer, "\treturn builder\n")
+	fmt.Fprintf(writer, "}\n\n")
+	if softDelete != "" {
+		fmt.Fprintf(writer, "// IncludeDeleted selects the soft deleted rows too\n")
+		fmt.Fprintf(writer, "func (builder *%s) IncludeDeleted() *%s {\n", builderType, builderType)
+		fmt.Fprintf(writer, "\tbuilder.query.softDelete = \"\"\n")
+		fmt.Fprintf(writer, "\treturn builder\n")
+		fmt.Fprintf(writer, "}\n\n")
+	}
+
+	fmt.Fprintf(writer, "func (builder *%s) All(ctx context.Context, q DBTX) ([]*%s, error) {\n", builderType, entityType)
+	fmt.Fprintf(writer, "\tquery, args := builder.query.build(%q)\n", sqlColumnList(columns))
+	fmt.Fprintf(writer, "\trows, err := q.QueryContext(ctx, query, args...)\n")
+	fmt.Fprintf(writer, "\tif err != nil {\n")
+	fmt.Fprintf(writer, "\t\treturn nil, err\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\tdefer rows.Close()\n")
+	fmt.Fprintf(writer, "\tresult := make([]*%s, 0, 0)\n", entityType)
+	fmt.Fprintf(writer, "\tfor rows.Next() {\n")
+	fmt.Fprintf(writer, "\t\tentity, err := rowsNoFetchResultSetTo%s(rows)\n", names.Entity)
+	fmt.Fprintf(writer, "\t\tif err != nil {\n")
+	fmt.Fprintf(writer, "\t\t\treturn nil, err\n")
+	fmt.Fprintf(writer, "\t\t}\n")
+	fmt.Fprintf(writer, "\t\tresult = append(result, entity)\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn result, rows.Err()\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "// First returns the first row, %s when none matches\n", notFoundErrorName(names))
+	fmt.Fprintf(writer, "func (builder *%s) First(ctx context.Context, q DBTX) (*%s, error) {\n", builderType, entityType)
+	fmt.Fprintf(writer, "\tfirst := *builder\n")
+	fmt.Fprintf(writer, "\tfirst.query.limit = 1\n")
+	fmt.Fprintf(writer, "\tresult, err := first.All(ctx, q)\n")
+	fmt.Fprintf(writer, "\tif err != nil {\n")
+	fmt.Fprintf(writer, "\t\treturn nil, err\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\tif len(result) == 0 {\n")
+	fmt.Fprintf(writer, "\t\treturn nil, %s\n", notFoundErrorName(names))
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn result[0], nil\n")
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "// Count counts the matching rows, ignoring the order, the limit and the offset\n")
+	fmt.Fprintf(writer, "func (builder *%s) Count(ctx context.Context, q DBTX) (int64, error) {\n", builderType)
+	fmt.Fprintf(writer, "\tcount := builder.query\n")
+	fmt.Fprintf(writer, "\tcount.orders = nil\n")
+	fmt.Fprintf(writer, "\tcount.limit = 0\n")
+	fmt.Fprintf(writer, "\tcount.offset = 0\n")
+	fmt.Fprintf(writer, "\tquery, args := count.build(\"count(*)\")\n")
+	fmt.Fprintf(writer, "\tvar result int64\n")
+	fmt.Fprintf(writer, "\terr := q.QueryRowContext(ctx, query, args...).Scan(&result)\n")
+	fmt.Fprintf(writer, "\treturn result, err\n")
+	fmt.Fprintf(writer, "}\n\n")
+	writeUserCodeRegion(writer, layout, USER_CODE_CODE)
+
+	writer.Flush()
+	return sink.writeFile(layout.fileName(KIND_DAO, builderType), buffer.Bytes())
+}
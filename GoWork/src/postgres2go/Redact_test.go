@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+// withSecrets runs fn with secrets as the only literal secrets
+func withSecrets(fn func(), literals ...string) {
+	saved := secrets
+	secrets = make([]string, 0, 0)
+	defer func() { secrets = saved }()
+	for _, literal := range literals {
+		addSecret(literal)
+	}
+	fn()
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"user='app' password='pw' host='db'", "user='app' password=***** host='db'"},
+		{"password=pw host=db", "password=***** host=db"},
+		{"PASSWORD = 'a b\\'c' host=db", "PASSWORD = ***** host=db"},
+		{"dial postgres://app:pw@db:5432/app failed", "dial postgres://app:*****@db:5432/app failed"},
+		{"postgresql://app:p%40ss@db/app?sslmode=disable", "postgresql://app:*****@db/app?sslmode=disable"},
+		{"postgres://app@db/app", "postgres://app@db/app"},
+		{"postgres://db:5432/app as app@example.com", "postgres://db:5432/app as app@example.com"},
+		{"auth failed for s3cr3t-value", "auth failed for *****"},
+		{"pw is short, a pwd is left alone", "pw is short, a pwd is left alone"},
+	}
+	withSecrets(func() {
+		for _, test := range tests {
+			if got := redact(test.input); got != test.expected {
+				t.Errorf("redact(%q) = %q, expected %q", test.input, got, test.expected)
+			}
+		}
+	}, "pw", "s3cr3t-value")
+}
+
+func TestRedactWriter(t *testing.T) {
+	withSecrets(func() {
+		var out bytes.Buffer
+		writer := NewRedactWriter(&out)
+		writer.Write([]byte("connect postgres://app:s3cr"))
+		if out.Len() != 0 {
+			t.Errorf("an incomplete line was written : %q", out.String())
+		}
+		writer.Write([]byte("3t-value@db/app\nauth s3cr3t"))
+		writer.Write([]byte("-value"))
+		writer.Flush()
+		expected := "connect postgres://app:*****@db/app\nauth *****"
+		if out.String() != expected {
+			t.Errorf("wrote %q, expected %q", out.String(), expected)
+		}
+	}, "s3cr3t-value")
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"github.com/lib/pq"
+	"io"
+	"sort"
+	"strings"
+)
+
+// RepositoryParam is a parameter of a repository method, Field names it in the
+// recorded calls of the mock
+type RepositoryParam struct {
+	Name   string
+	Field  string
+	Type   string
+	Column *Column // nil when the parameter is not a column
+}
+
+// RepositoryMethod maps a method of <Entity>Repository to its DAO function,
+// every method takes a context first and returns an error last
+type RepositoryMethod struct {
+	Name   string
+	Func   string
+	Params []*RepositoryParam
+	Result string // type of the value returned before the error, empty if none
+}
+
+// primaryKeyParams returns the parameters matching a row by its primary key
+// column, ex id int64, none for a table without a single column key
+func primaryKeyParams(table *Table, names *TableNames) []*RepositoryParam {
+	result := make([]*RepositoryParam, 0, 0)
+	for i, column := range table.goColumns() {
+		if column.IsPrimary {
+			result = append(result, &RepositoryParam{Name: names.Params[i], Field: names.Fields[i], Type: columnGoType(column), Column: column})
+		}
+	}
+	return result
+}
+
+// keyCondition returns the where clause of params numbered from first, ex id=$1
+func keyCondition(params []*RepositoryParam, first int) string {
+	conditions := make([]string, 0, len(params))
+	for i, param := range params {
+		conditions = append(conditions, fmt.Sprintf("%s=$%d", pq.QuoteIdentifier(param.Column.Name), first+i))
+	}
+	return strings.Join(conditions, " and ")
+}
+
+// paramDeclarations returns params as declared by a signature, ex id int64
+func paramDeclarations(params []*RepositoryParam) string {
+	declarations := make([]string, 0, len(params))
+	for _, param := range params {
+		declarations = append(declarations, param.Name+" "+param.Type)
+	}
+	return strings.Join(declarations, ", ")
+}
+
+// paramNames returns the names of params separated by sep, ex id
+func paramNames(params []*RepositoryParam, sep string) string {
+	result := make([]string, 0, len(params))
+	for _, param := range params {
+		result = append(result, param.Name)
+	}
+	return strings.Join(result, sep)
+}
+
+func NewRepositoryMethod(name_ string, func_ string, params_ []*RepositoryParam, result_ string) *RepositoryMethod {
+	return &RepositoryMethod{Name: name_, Func: func_, Params: params_, Result: result_}
+}
+
+// repositoryMethods lists the DAO functions of table, in the order of the DAO file
+func repositoryMethods(layout *OutputLayout, table *Table) []*RepositoryMethod {
+	names := layout.naming.tableNames(table)
+	entityType := layout.qualifier(KIND_DAO, KIND_MODEL) + names.Entity
+	result := make([]*RepositoryMethod, 0, 0)
+
+	keyParams := primaryKeyParams(table, names)
+	softDelete := table.softDeleteColumn()
+	if len(keyParams) > 0 {
+		result = append(result, NewRepositoryMethod("LoadByID", layout.funcName("load"+names.Entity+"ByID"), keyParams, "*"+entityType))
+		if softDelete != nil {
+			result = append(result, NewRepositoryMethod("LoadByIDIncludeDeleted", layout.funcName("load"+names.Entity+"ByIDIncludeDeleted"), keyParams, "*"+entityType))
+		}
+	}
+
+	createParams := make([]*RepositoryParam, 0, 0)
+	for i, column := range table.goColumns() {
+		if table.isCreateParam(column) {
+			createParams = append(createParams, &RepositoryParam{Name: names.Params[i], Field: names.Fields[i], Type: columnGoType(column), Column: column})
+		}
+	}
+	result = append(result, NewRepositoryMethod("Create", layout.funcName("create"+names.Entity), createParams, "*"+entityType))
+	if softDelete != nil && len(keyParams) > 0 {
+		result = append(result,
+			NewRepositoryMethod("SoftDelete", layout.funcName("softDelete"+names.Entity), keyParams, ""),
+			NewRepositoryMethod("Restore", layout.funcName("restore"+names.Entity), keyParams, ""))
+	}
+	if table.hasUpdate() {
+		entityParam := []*RepositoryParam{{Name: "entity", Field: "Entity", Type: "*" + entityType}}
+		result = append(result, NewRepositoryMethod("Update", layout.funcName("update"+names.Entity), entityParam, "*"+entityType))
+	}
+
+	if len(createParams) > 0 {
+		entitiesParam := []*RepositoryParam{{Name: "entities", Field: "Entities", Type: "[]*" + entityType}}
+		result = append(result, NewRepositoryMethod("BulkInsertReturning", layout.funcName("bulkInsert"+names.Entity+"Returning"), entitiesParam, "[]*"+entityType))
+	}
+	return result
+}
+
+func findRepositoryMethod(methods []*RepositoryMethod, name string) *RepositoryMethod {
+	for _, method := range methods {
+		if method.Name == name {
+			return method
+		}
+	}
+	return nil
+}
+
+// repositoryImports returns the packages the signatures of methods need
+func repositoryImports(layout *OutputLayout, methods []*RepositoryMethod, imported ...string) []string {
+	columns := make([]*Column, 0, 0)
+	for _, method := range methods {
+		for _, param := range method.Params {
+			if param.Column != nil {
+				columns = append(columns, param.Column)
+			}
+		}
+	}
+	result := append(entityImports(columns, imported...), imported...)
+	sort.Strings(result)
+	return append(result, layout.imports(KIND_DAO, KIND_MODEL)...)
+}
+
+// signature returns the parameters and the results of method, ex
+// (ctx context.Context, id int64) (*User, error)
+func (method *RepositoryMethod) signature() string {
+	params := []string{"ctx context.Context"}
+	for _, param := range method.Params {
+		params = append(params, param.Name+" "+param.Type)
+	}
+	if method.Result == "" {
+		return "(" + strings.Join(params, ", ") + ") error"
+	}
+	return "(" + strings.Join(params, ", ") + ") (" + method.Result + ", error)"
+}
+
+// errorResults returns the results of method failing with err, ex nil, err
+func (method *RepositoryMethod) errorResults(err string) string {
+	if method.Result == "" {
+		return err
+	}
+	return "nil, " + err
+}
+
+// arguments returns the names of the parameters, ex ctx, id
+func (method *RepositoryMethod) arguments() string {
+	args := []string{"ctx"}
+	for _, param := range method.Params {
+		args = append(args, param.Name)
+	}
+	return strings.Join(args, ", ")
+}
+
+func writeImports(writer io.Writer, importPaths []string) {
+	fmt.Fprintf(writer, "import (\n")
+	for _, importPath := range importPaths {
+		fmt.Fprintf(writer, "\t\"%s\"\n", importPath)
+	}
+	fmt.Fprintf(writer, ")\n\n")
+}
+
+// generateGoRepository writes the <Entity>Repository interface and its
+// implementation on a DBTX, the one of a *sql.DB or of a transaction
+func generateGoRepository(layout *OutputLayout, sink OutputSink, table *Table) error {
+	names := layout.naming.tableNames(table)
+	methods := repositoryMethods(layout, table)
+	interfaceName := names.Entity + "Repository"
+	structName := names.Entity + "SqlRepository"
+	var buffer bytes.Buffer
+	writer := bufio.NewWriter(&buffer)
+	writeGeneratedHeader(writer, layout, KIND_DAO, table)
+	writeImports(writer, repositoryImports(layout, methods, "context"))
+	writeUserCodeRegion(writer, layout, USER_CODE_IMPORTS)
+
+	fmt.Fprintf(writer, "// %s is the contract of the %s DAO functions, depend on it and test with\n", interfaceName, table.Name)
+	fmt.Fprintf(writer, "// %sMock\n", interfaceName)
+	fmt.Fprintf(writer, "type %s interface {\n", interfaceName)
+	for _, method := range methods {
+		fmt.Fprintf(writer, "\t%s%s\n", method.Name, method.signature())
+	}
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "// %s runs the DAO functions on q\n", structName)
+	fmt.Fprintf(writer, "type %s struct {\n", structName)
+	fmt.Fprintf(writer, "\tq DBTX\n")
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "var _ %s = (*%s)(nil)\n\n", interfaceName, structName)
+
+	fmt.Fprintf(writer, "func New%s(q_ DBTX) *%s {\n", structName, structName)
+	fmt.Fprintf(writer, "\treturn &%s{q: q_}\n", structName)
+	fmt.Fprintf(writer, "}\n\n")
+
+	for _, method := range methods {
+		fmt.Fprintf(writer, "func (repository *%s) %s%s {\n", structName, method.Name, method.signature())
+		fmt.Fprintf(writer, "\treturn %s(ctx, repository.q%s)\n", method.Func, strings.TrimPrefix(method.arguments(), "ctx"))
+		fmt.Fprintf(writer, "}\n\n")
+	}
+	writeUserCodeRegion(writer, layout, USER_CODE_CODE)
+
+	writer.Flush()
+	return sink.writeFile(layout.fileName(KIND_DAO, interfaceName), buffer.Bytes())
+}
+
+// generateGoRepositoryMock writes <Entity>RepositoryMock : every call is
+// recorded, a method runs its <Method>Func when set and otherwise returns its
+// <Method>Result and <Method>Err
+func generateGoRepositoryMock(layout *OutputLayout, sink OutputSink, table *Table) error {
+	names := layout.naming.tableNames(table)
+	methods := repositoryMethods(layout, table)
+	interfaceName := names.Entity + "Repository"
+	mockName := interfaceName + "Mock"
+	var buffer bytes.Buffer
+	writer := bufio.NewWriter(&buffer)
+	writeGeneratedHeader(writer, layout, KIND_DAO, table)
+	writeImports(writer, repositoryImports(layout, methods, "context", "sync"))
+	writeUserCodeRegion(writer, layout, USER_CODE_IMPORTS)
+
+	for _, method := range methods {
+		fmt.Fprintf(writer, "type %s%sCall struct {\n", interfaceName, method.Name)
+		fmt.Fprintf(writer, "\tCtx context.Context\n")
+		for _, param := range method.Params {
+			fmt.Fprintf(writer, "\t%s %s\n", param.Field, param.Type)
+		}
+		fmt.Fprintf(writer, "}\n\n")
+	}
+
+	fmt.Fprintf(writer, "type %s struct {\n", mockName)
+	fmt.Fprintf(writer, "\tlock sync.Mutex\n")
+	for _, method := range methods {
+		fmt.Fprintf(writer, "\n")
+		fmt.Fprintf(writer, "\t%sFunc func%s\n", method.Name, method.signature())
+		if method.Result != "" {
+			fmt.Fprintf(writer, "\t%sResult %s\n", method.Name, method.Result)
+		}
+		fmt.Fprintf(writer, "\t%sErr error\n", method.Name)
+		fmt.Fprintf(writer, "\t%sCalls []%s%sCall\n", method.Name, interfaceName, method.Name)
+	}
+	fmt.Fprintf(writer, "}\n\n")
+	fmt.Fprintf(writer, "var _ %s = (*%s)(nil)\n\n", interfaceName, mockName)
+
+	for _, method := range methods {
+		fmt.Fprintf(writer, "func (mock *%s) %s%s {\n", mockName, method.Name, method.signature())
+		fmt.Fprintf(writer, "\tmock.lock.Lock()\n")
+		fmt.Fprintf(writer, "\tmock.%sCalls = append(mock.%sCalls, %s%sCall{Ctx: ctx", method.Name, method.Name, interfaceName, method.Name)
+		for _, param := range method.Params {
+			fmt.Fprintf(writer, ", %s: %s", param.Field, param.Name)
+		}
+		fmt.Fprintf(writer, "})\n")
+		if method.Result != "" {
+			fmt.Fprintf(writer, "\tfn, result, err := mock.%sFunc, mock.%sResult, mock.%sErr\n", method.Name, method.Name, method.Name)
+		} else {
+			fmt.Fprintf(writer, "\tfn, err := mock.%sFunc, mock.%sErr\n", method.Name, method.Name)
+		}
+		fmt.Fprintf(writer, "\tmock.lock.Unlock()\n")
+		fmt.Fprintf(writer, "\tif fn != nil {\n")
+		fmt.Fprintf(writer, "\t\treturn fn(%s)\n", method.arguments())
+		fmt.Fprintf(writer, "\t}\n")
+		if method.Result != "" {
+			fmt.Fprintf(writer, "\treturn result, err\n")
+		} else {
+			fmt.Fprintf(writer, "\treturn err\n")
+		}
+		fmt.Fprintf(writer, "}\n\n")
+	}
+	writeUserCodeRegion(writer, layout, USER_CODE_CODE)
+
+	writer.Flush()
+	return sink.writeFile(layout.fileName(KIND_DAO, mockName), buffer.Bytes())
+}
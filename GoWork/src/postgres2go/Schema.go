@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+)
+
+type Schema struct {
+	Database string
+	Tables   []*Table
+	Enums    []*Enum
+}
+
+func NewSchema(database_ string) *Schema {
+	return &Schema{Database: database_, Tables: make([]*Table, 0, 0), Enums: make([]*Enum, 0, 0)}
+}
+
+// resolveConstraints flags primary and foreign key columns from the constraint definitions
+func (schema *Schema) resolveConstraints(logWriter *bufio.Writer) {
+	fmt.Fprintln(logWriter, "Generate Primary Keys")
+	fmt.Fprintln(console, "Generate Primary Keys")
+	for _, table := range schema.Tables {
+		table.generatePrimaryKeyConstraint()
+	}
+
+	fmt.Fprintln(logWriter, "Generate Foreign Keys")
+	fmt.Fprintln(console, "Generate Foreign Keys")
+	for _, table := range schema.Tables {
+		table.generateForeignKeysConstraint(logWriter)
+	}
+}
+
+// resolveEnums flags the columns of an enum type or an array of one, format_type
+// qualifies an enum out of the search path with its schema
+func (schema *Schema) resolveEnums() {
+	enums := make(map[string]bool)
+	for _, enum := range schema.Enums {
+		enums[enum.Name] = true
+	}
+	for _, table := range schema.Tables {
+		for _, column := range table.columns {
+			name := strings.TrimSuffix(column.Type, "[]")
+			name = name[strings.LastIndex(name, ".")+1:]
+			column.IsEnum = enums[strings.Trim(name, "\"")]
+		}
+	}
+}
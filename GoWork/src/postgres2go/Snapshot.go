@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+const SNAPSHOT_VERSION = 1
+
+// SchemaSnapshot is the versioned json form of an introspected schema, it only
+// keeps what the catalog returned, primary and foreign key columns are derived
+// again when it is loaded
+type SchemaSnapshot struct {
+	Version  int             `json:"version"`
+	Database string          `json:"database,omitempty"`
+	Tables   []TableSnapshot `json:"tables"`
+	Enums    []EnumSnapshot  `json:"enums"`
+}
+
+type TableSnapshot struct {
+	Name                 string               `json:"name"`
+	PrimaryKeyName       string               `json:"primaryKeyName,omitempty"`
+	PrimaryKeyConstraint string               `json:"primaryKeyConstraint,omitempty"`
+	Comment              string               `json:"comment,omitempty"`
+	Columns              []ColumnSnapshot     `json:"columns"`
+	ForeignKeys          []ForeignKeySnapshot `json:"foreignKeys"`
+	Indexes              []IndexSnapshot      `json:"indexes"`
+}
+
+type ColumnSnapshot struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	IsNullable bool   `json:"isNullable"`
+	Comment    string `json:"comment,omitempty"`
+}
+
+type ForeignKeySnapshot struct {
+	Name       string `json:"name"`
+	Constraint string `json:"constraint"`
+}
+
+type IndexSnapshot struct {
+	Name       string   `json:"name"`
+	Definition string   `json:"definition"`
+	Constraint string   `json:"constraint,omitempty"`
+	IsPrimary  bool     `json:"isPrimary"`
+	IsUnique   bool     `json:"isUnique"`
+	Columns    []string `json:"columns"`
+}
+
+type EnumSnapshot struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+func NewSchemaSnapshot(schema *Schema) *SchemaSnapshot {
+	snapshot := &SchemaSnapshot{Version: SNAPSHOT_VERSION, Database: schema.Database, Tables: make([]TableSnapshot, 0, len(schema.Tables)), Enums: make([]EnumSnapshot, 0, len(schema.Enums))}
+	for _, table := range schema.Tables {
+		tableSnapshot := TableSnapshot{Name: table.Name, PrimaryKeyName: table.PrimaryKeyName, PrimaryKeyConstraint: table.PrimaryKeyConstraint, Comment: table.Comment, Columns: make([]ColumnSnapshot, 0, len(table.columns)), ForeignKeys: make([]ForeignKeySnapshot, 0, len(table.foreignKeys)), Indexes: make([]IndexSnapshot, 0, len(table.indexes))}
+		for _, column := range table.columns {
+			tableSnapshot.Columns = append(tableSnapshot.Columns, ColumnSnapshot{Name: column.Name, Type: column.Type, IsNullable: column.IsNullable, Comment: column.Comment})
+		}
+		for _, foreignKey := range table.foreignKeys {
+			tableSnapshot.ForeignKeys = append(tableSnapshot.ForeignKeys, ForeignKeySnapshot{Name: foreignKey.Name, Constraint: foreignKey.ForeignKeyConstraint})
+		}
+		for _, index := range table.indexes {
+			columns := append(make([]string, 0, len(index.Columns)), index.Columns...)
+			tableSnapshot.Indexes = append(tableSnapshot.Indexes, IndexSnapshot{Name: index.Name, Definition: index.Definition, Constraint: index.Constraint, IsPrimary: index.IsPrimary, IsUnique: index.IsUnique, Columns: columns})
+		}
+		snapshot.Tables = append(snapshot.Tables, tableSnapshot)
+	}
+	for _, enum := range schema.Enums {
+		values := append(make([]string, 0, len(enum.Values)), enum.Values...)
+		snapshot.Enums = append(snapshot.Enums, EnumSnapshot{Name: enum.Name, Values: values})
+	}
+	return snapshot
+}
+
+// toSchema rebuilds the model, constraints still have to be resolved
+func (snapshot *SchemaSnapshot) toSchema() *Schema {
+	schema := NewSchema(snapshot.Database)
+	for _, tableSnapshot := range snapshot.Tables {
+		// there is no oid outside of a database, the name is unique in the snapshot
+		table := NewTable(tableSnapshot.Name, tableSnapshot.Name)
+		table.PrimaryKeyName = tableSnapshot.PrimaryKeyName
+		table.PrimaryKeyConstraint = tableSnapshot.PrimaryKeyConstraint
+		table.Comment = tableSnapshot.Comment
+		table.columns = make([]*Column, 0, len(tableSnapshot.Columns))
+		for _, columnSnapshot := range tableSnapshot.Columns {
+			column := NewColumn(columnSnapshot.Name, columnSnapshot.Type, columnSnapshot.IsNullable)
+			column.Comment = columnSnapshot.Comment
+			table.columns = append(table.columns, column)
+		}
+		table.foreignKeys = make([]*ForeignKey, 0, len(tableSnapshot.ForeignKeys))
+		for _, foreignKeySnapshot := range tableSnapshot.ForeignKeys {
+			table.foreignKeys = append(table.foreignKeys, NewForeignKey(foreignKeySnapshot.Name, foreignKeySnapshot.Constraint))
+		}
+		table.indexes = make([]*Index, 0, len(tableSnapshot.Indexes))
+		for _, indexSnapshot := range tableSnapshot.Indexes {
+			columns := append(make([]string, 0, len(indexSnapshot.Columns)), indexSnapshot.Columns...)
+			table.indexes = append(table.indexes, NewIndex(indexSnapshot.Name, indexSnapshot.Definition, indexSnapshot.Constraint, indexSnapshot.IsPrimary, indexSnapshot.IsUnique, columns))
+		}
+		schema.Tables = append(schema.Tables, table)
+	}
+	for _, enumSnapshot := range snapshot.Enums {
+		enum := NewEnum(enumSnapshot.Name)
+		enum.Values = append(enum.Values, enumSnapshot.Values...)
+		schema.Enums = append(schema.Enums, enum)
+	}
+	return schema
+}
+
+func (snapshot *SchemaSnapshot) marshal() ([]byte, error) {
+	content, err := json.MarshalIndent(snapshot, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
+
+func readSnapshot(fileName string) (*SchemaSnapshot, error) {
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("can not read snapshot %s : %s", fileName, err.Error())
+	}
+	// like the config, an unknown field is a typo or a newer format, its data
+	// would be lost
+	snapshot := new(SchemaSnapshot)
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	err = decoder.Decode(snapshot)
+	if err == nil && decoder.More() {
+		err = fmt.Errorf("data after the snapshot")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can not parse snapshot %s : %s", fileName, err.Error())
+	}
+	if snapshot.Version != SNAPSHOT_VERSION {
+		return nil, fmt.Errorf("unsupported snapshot version %d in %s, expected %d", snapshot.Version, fileName, SNAPSHOT_VERSION)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadSnapshotErrors(t *testing.T) {
+	tests := []struct {
+		content  string
+		expected string
+	}{
+		{`{"version": 1, "tables": [{"name": "user", "columns": [{"name": "id", "type": "bigint", "nullable": true}]}]}`, `unknown field "nullable"`},
+		{`{"version": 1, "tabels": []}`, `unknown field "tabels"`},
+		{`{"version": 1} {}`, "data after the snapshot"},
+		{`{"version": 2}`, "unsupported snapshot version 2"},
+	}
+	directory, err := ioutil.TempDir("", "postgres2go-snapshot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+	fileName := filepath.Join(directory, "schema.json")
+	for _, test := range tests {
+		err := ioutil.WriteFile(fileName, []byte(test.content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = readSnapshot(fileName)
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("%s : expected an error with %q, got %v", test.content, test.expected, err)
+		}
+	}
+}
+
+func TestReadSnapshot(t *testing.T) {
+	directory, err := ioutil.TempDir("", "postgres2go-snapshot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+	fileName := filepath.Join(directory, "schema.json")
+	content := `{"version": 1, "tables": [{"name": "user", "columns": [{"name": "id", "type": "bigint", "isNullable": false}], "foreignKeys": [], "indexes": []}], "enums": []}`
+	err = ioutil.WriteFile(fileName, []byte(content), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot, err := readSnapshot(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshot.Tables) != 1 || snapshot.Tables[0].Columns[0].Name != "id" {
+		t.Errorf("unexpected snapshot %+v", snapshot)
+	}
+}
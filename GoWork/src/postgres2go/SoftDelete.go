@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"github.com/lib/pq"
+	"io"
+	"strings"
+)
+
+// the column of the soft delete found without configuration
+const SOFT_DELETE_COLUMN_NAME = "deleted_at"
+
+// isSoftDeleteColumn tells whether column can hold the time of a soft delete,
+// a nullable timestamp the entity holds in a sql.NullTime
+func isSoftDeleteColumn(column *Column) bool {
+	return !column.IsPrimary && column.IsNullable && isNullWrapped(column) && strings.HasPrefix(column.Type, "timestamp")
+}
+
+// SoftDeleteVariant is a variant of the functions reading a table, Condition
+// hides the soft deleted rows, empty when the variant reads every row
+type SoftDeleteVariant struct {
+	Suffix    string
+	Condition string
+}
+
+// softDeleteVariants returns the variant reading the rows not deleted and
+// the IncludeDeleted one, or a single variant for a table without soft delete
+func softDeleteVariants(table *Table) []*SoftDeleteVariant {
+	column := table.softDeleteColumn()
+	if column == nil {
+		return []*SoftDeleteVariant{{}}
+	}
+	return []*SoftDeleteVariant{{Condition: pq.QuoteIdentifier(column.Name) + " is null"}, {Suffix: "IncludeDeleted"}}
+}
+
+// writeSoftDelete writes softDelete<Entity>, setting the soft delete column of
+// a row to now(), and restore<Entity>, setting it back to null
+func writeSoftDelete(writer io.Writer, layout *OutputLayout, table *Table, names *TableNames) {
+	column := table.softDeleteColumn()
+	keyParams := primaryKeyParams(table, names)
+	if column == nil || len(keyParams) == 0 {
+		return
+	}
+	touch := ""
+	updatedAt := table.updatedAtColumn()
+	if updatedAt != nil && table.isAuditTimestamp(updatedAt) {
+		touch = "," + pq.QuoteIdentifier(updatedAt.Name) + "=now()"
+	}
+	tableName := pq.QuoteIdentifier(table.Name)
+	columnName := pq.QuoteIdentifier(column.Name)
+	softDeleteFunc := layout.funcName("softDelete" + names.Entity)
+	restoreFunc := layout.funcName("restore" + names.Entity)
+	fmt.Fprintf(writer, "// %s hides the row from the functions without IncludeDeleted,\n", softDeleteFunc)
+	fmt.Fprintf(writer, "// %s when there is no such row or it is already deleted\n", notFoundErrorName(names))
+	fmt.Fprintf(writer, "func %s(ctx context.Context, q DBTX, %s) error {\n", softDeleteFunc, paramDeclarations(keyParams))
+	writeSoftDeleteExec(writer, names, keyParams, fmt.Sprintf("update %s set %s=now()%s where %s and %s is null", tableName, columnName, touch, keyCondition(keyParams, 1), columnName))
+	fmt.Fprintf(writer, "}\n\n")
+
+	fmt.Fprintf(writer, "// %s undoes %s, %s when there is no such\n", restoreFunc, softDeleteFunc, notFoundErrorName(names))
+	fmt.Fprintf(writer, "// deleted row\n")
+	fmt.Fprintf(writer, "func %s(ctx context.Context, q DBTX, %s) error {\n", restoreFunc, paramDeclarations(keyParams))
+	writeSoftDeleteExec(writer, names, keyParams, fmt.Sprintf("update %s set %s=null%s where %s and %s is not null", tableName, columnName, touch, keyCondition(keyParams, 1), columnName))
+	fmt.Fprintf(writer, "}\n\n")
+}
+
+func writeSoftDeleteExec(writer io.Writer, names *TableNames, keyParams []*RepositoryParam, query string) {
+	fmt.Fprintf(writer, "\tresult, err := q.ExecContext(ctx, %q, %s)\n", query, paramNames(keyParams, ", "))
+	fmt.Fprintf(writer, "\tif err != nil {\n")
+	fmt.Fprintf(writer, "\t\treturn %s(err)\n", mapErrorFuncName(names))
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\tcount, err := result.RowsAffected()\n")
+	fmt.Fprintf(writer, "\tif err != nil {\n")
+	fmt.Fprintf(writer, "\t\treturn err\n")
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\tif count == 0 {\n")
+	fmt.Fprintf(writer, "\t\treturn %s\n", notFoundErrorName(names))
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn nil\n")
+}
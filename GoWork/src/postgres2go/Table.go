@@ -2,6 +2,8 @@ package main
 
 import (
 	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"regexp"
 	"strings"
@@ -15,6 +17,14 @@ type Table struct {
 	PrimaryKeyName       string
 	PrimaryKeyConstraint string
 	foreignKeys          []*ForeignKey
+	indexes              []*Index
+	Comment              string
+	GoName               string
+	// overrides of the config tables section
+	VersionColumn    string
+	SoftDeleteColumn string
+	CreatedAtColumn  string
+	UpdatedAtColumn  string
 }
 
 func NewTable(oid_ string, name_ string) *Table {
@@ -50,3 +60,66 @@ func (table *Table) generateForeignKeysConstraint(logWriter *bufio.Writer) {
 		}
 	}
 }
+
+// goColumns returns the columns the generated code maps, without the omitted ones
+func (table *Table) goColumns() []*Column {
+	result := make([]*Column, 0, len(table.columns))
+	for _, column := range table.columns {
+		if !column.Omit {
+			result = append(result, column)
+		}
+	}
+	return result
+}
+
+// goColumn returns the mapped column named name, nil when none
+func (table *Table) goColumn(name string) *Column {
+	for _, column := range table.goColumns() {
+		if column.Name == name {
+			return column
+		}
+	}
+	return nil
+}
+
+// versionColumn returns the column of the optimistic locking : the configured
+// one, else an integer version or a timestamp updated_at, nil when none
+func (table *Table) versionColumn() *Column {
+	if table.VersionColumn != "" {
+		return table.goColumn(table.VersionColumn)
+	}
+	for _, name := range []string{VERSION_COLUMN_NAME, UPDATED_AT_COLUMN_NAME} {
+		column := table.goColumn(name)
+		if column != nil && isVersionColumn(column) {
+			return column
+		}
+	}
+	return nil
+}
+
+// softDeleteColumn returns the timestamp set by the soft delete : the
+// configured one, else a nullable deleted_at, nil when none
+func (table *Table) softDeleteColumn() *Column {
+	if table.SoftDeleteColumn != "" {
+		return table.goColumn(table.SoftDeleteColumn)
+	}
+	column := table.goColumn(SOFT_DELETE_COLUMN_NAME)
+	if column != nil && isSoftDeleteColumn(column) {
+		return column
+	}
+	return nil
+}
+
+// fingerprint identifies the table definition the generated files come from
+func (table *Table) fingerprint() string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "table %s\n", table.Name)
+	for _, column := range table.columns {
+		fmt.Fprintf(hash, "column %s %s %t %t %t\n", column.Name, column.Type, column.IsNullable, column.IsPrimary, column.IsForeign)
+	}
+	fmt.Fprintf(hash, "primary %s\n", table.PrimaryKeyConstraint)
+	for _, foreignKey := range table.foreignKeys {
+		fmt.Fprintf(hash, "foreign %s %s\n", foreignKey.Name, foreignKey.ForeignKeyConstraint)
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
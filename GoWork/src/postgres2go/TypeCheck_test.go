@@ -0,0 +1,190 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// the subset of github.com/lib/pq the generated code may use, the real
+// package is not needed to type check
+const PQ_STUB_SOURCE = `package pq
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+type ErrorCode string
+
+func (ec ErrorCode) Name() string { return string(ec) }
+
+type Error struct {
+	Severity   string
+	Code       ErrorCode
+	Message    string
+	Detail     string
+	Hint       string
+	Table      string
+	Column     string
+	Constraint string
+}
+
+func (err *Error) Error() string { return "pq: " + err.Message }
+
+func CopyIn(table string, columns ...string) string { return "" }
+
+type arrayStub struct{}
+
+func (arrayStub) Scan(src interface{}) error { return nil }
+
+func (arrayStub) Value() (driver.Value, error) { return nil, nil }
+
+type StringArray []string
+
+func (a *StringArray) Scan(src interface{}) error { return nil }
+
+func (a StringArray) Value() (driver.Value, error) { return nil, nil }
+
+type Int64Array []int64
+
+func (a *Int64Array) Scan(src interface{}) error { return nil }
+
+func (a Int64Array) Value() (driver.Value, error) { return nil, nil }
+
+type Float64Array []float64
+
+func (a *Float64Array) Scan(src interface{}) error { return nil }
+
+func (a Float64Array) Value() (driver.Value, error) { return nil, nil }
+
+type BoolArray []bool
+
+func (a *BoolArray) Scan(src interface{}) error { return nil }
+
+func (a BoolArray) Value() (driver.Value, error) { return nil, nil }
+
+type ByteaArray [][]byte
+
+func (a *ByteaArray) Scan(src interface{}) error { return nil }
+
+func (a ByteaArray) Value() (driver.Value, error) { return nil, nil }
+
+func Array(a interface{}) interface {
+	driver.Valuer
+	sql.Scanner
+} {
+	return arrayStub{}
+}
+`
+
+var standardImporterOnce sync.Once
+var standardImporter types.Importer
+var standardFileSet = token.NewFileSet()
+
+type typeCheckImporter struct {
+	sources  map[string]map[string]string
+	packages map[string]*types.Package
+	errors   []string
+}
+
+func (checker *typeCheckImporter) Import(importPath string) (*types.Package, error) {
+	if pkg, exists := checker.packages[importPath]; exists {
+		return pkg, nil
+	}
+	if files, exists := checker.sources[importPath]; exists {
+		return checker.check(importPath, files), nil
+	}
+	standardImporterOnce.Do(func() {
+		standardImporter = importer.ForCompiler(standardFileSet, "source", nil)
+	})
+	return standardImporter.Import(importPath)
+}
+
+func (checker *typeCheckImporter) check(importPath string, files map[string]string) *types.Package {
+	fileNames := make([]string, 0, len(files))
+	for fileName := range files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	fset := token.NewFileSet()
+	errorCount := len(checker.errors)
+	parsed := make([]*ast.File, 0, len(files))
+	for _, fileName := range fileNames {
+		file, err := parser.ParseFile(fset, fileName, files[fileName], parser.AllErrors)
+		if err != nil {
+			if list, ok := err.(scanner.ErrorList); ok {
+				for _, parseError := range list {
+					checker.errors = append(checker.errors, parseError.Error())
+				}
+			} else {
+				checker.errors = append(checker.errors, err.Error())
+			}
+		}
+		if file != nil {
+			parsed = append(parsed, file)
+		}
+	}
+	if len(parsed) < len(fileNames) || len(checker.errors) > errorCount {
+		// type errors on top of syntax errors are noise
+		pkg := types.NewPackage(importPath, path.Base(importPath))
+		checker.packages[importPath] = pkg
+		return pkg
+	}
+	config := types.Config{
+		Importer: checker,
+		Error: func(err error) {
+			if typeError, ok := err.(types.Error); ok {
+				position := typeError.Fset.Position(typeError.Pos)
+				checker.errors = append(checker.errors, fmt.Sprintf("%s:%d:%d: %s", position.Filename, position.Line, position.Column, typeError.Msg))
+			} else {
+				checker.errors = append(checker.errors, err.Error())
+			}
+		},
+	}
+	pkg, _ := config.Check(importPath, fset, parsed, nil)
+	checker.packages[importPath] = pkg
+	return pkg
+}
+
+// typeCheckGenerated type checks the generated packages, files are relative to
+// the output directory, and returns every parse and type error
+func typeCheckGenerated(files map[string]string, config OutputConfig) []string {
+	importRoot := config.ImportPath
+	if importRoot == "" {
+		importRoot = "generated"
+	}
+	checker := &typeCheckImporter{sources: make(map[string]map[string]string), packages: make(map[string]*types.Package), errors: make([]string, 0, 0)}
+	checker.sources[PQ_IMPORT_PATH] = map[string]string{"pq.go": PQ_STUB_SOURCE}
+	for relative, content := range files {
+		if !strings.HasSuffix(relative, ".go") {
+			continue
+		}
+		importPath := path.Join(importRoot, path.Dir(relative))
+		if checker.sources[importPath] == nil {
+			checker.sources[importPath] = make(map[string]string)
+		}
+		checker.sources[importPath][relative] = content
+	}
+	importPaths := make([]string, 0, len(checker.sources))
+	for importPath := range checker.sources {
+		if importPath != PQ_IMPORT_PATH {
+			importPaths = append(importPaths, importPath)
+		}
+	}
+	sort.Strings(importPaths)
+	for _, importPath := range importPaths {
+		checker.Import(importPath)
+	}
+	sort.Strings(checker.errors)
+	return checker.errors
+}
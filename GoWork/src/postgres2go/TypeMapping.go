@@ -0,0 +1,237 @@
+package main
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// GoTypeMapping describes how a postgres type is held by the entity, nullable
+// or not, and by the json struct
+type GoTypeMapping struct {
+	Type          string // entity type of a NOT NULL column
+	NullType      string // entity type of a nullable column
+	NullField     string // value field of NullType, ex String for sql.NullString
+	NullFieldType string // type of NullField when it differs from Type, ex int32
+	JsonType      string // json type, a nullable column is a pointer to it
+	FmtType       string
+	Import        string // package of Type and JsonType
+	IsTime        bool   // held as an RFC 3339 string by the json struct
+	IsArray       bool   // a pq array type, nil is null
+}
+
+var STRING_MAPPING = GoTypeMapping{Type: "string", NullType: "sql.NullString", NullField: "String", JsonType: "string", FmtType: "%s"}
+var TIME_MAPPING = GoTypeMapping{Type: "time.Time", NullType: "sql.NullTime", NullField: "Time", JsonType: "string", FmtType: "%v", Import: "time", IsTime: true}
+var JSON_MAPPING = GoTypeMapping{Type: "json.RawMessage", NullType: "json.RawMessage", JsonType: "json.RawMessage", FmtType: "%s", Import: "encoding/json"}
+var STRING_ARRAY_MAPPING = GoTypeMapping{Type: "pq.StringArray", NullType: "pq.StringArray", JsonType: "[]string", FmtType: "%v", Import: PQ_IMPORT_PATH, IsArray: true}
+var INT_ARRAY_MAPPING = GoTypeMapping{Type: "pq.Int64Array", NullType: "pq.Int64Array", JsonType: "[]int64", FmtType: "%v", Import: PQ_IMPORT_PATH, IsArray: true}
+var FLOAT_ARRAY_MAPPING = GoTypeMapping{Type: "pq.Float64Array", NullType: "pq.Float64Array", JsonType: "[]float64", FmtType: "%v", Import: PQ_IMPORT_PATH, IsArray: true}
+
+// keyed by the format_type name without its modifier, ex character varying(20) --> character varying
+var POSTGRES_TYPE_MAPPINGS = map[string]GoTypeMapping{
+	"text":                        STRING_MAPPING,
+	"character varying":           STRING_MAPPING,
+	"character":                   STRING_MAPPING,
+	"citext":                      STRING_MAPPING,
+	"uuid":                        STRING_MAPPING,
+	"numeric":                     STRING_MAPPING,
+	"time without time zone":      STRING_MAPPING,
+	"time with time zone":         STRING_MAPPING,
+	"smallint":                    {Type: "int16", NullType: "sql.NullInt16", NullField: "Int16", JsonType: "int16", FmtType: "%d"},
+	"integer":                     {Type: "int", NullType: "sql.NullInt32", NullField: "Int32", NullFieldType: "int32", JsonType: "int", FmtType: "%d"},
+	"bigint":                      {Type: "int64", NullType: "sql.NullInt64", NullField: "Int64", JsonType: "int64", FmtType: "%d"},
+	"real":                        {Type: "float32", NullType: "sql.NullFloat64", NullField: "Float64", NullFieldType: "float64", JsonType: "float32", FmtType: "%f"},
+	"double precision":            {Type: "float64", NullType: "sql.NullFloat64", NullField: "Float64", JsonType: "float64", FmtType: "%f"},
+	"boolean":                     {Type: "bool", NullType: "sql.NullBool", NullField: "Bool", JsonType: "bool", FmtType: "%t"},
+	"bytea":                       {Type: "[]byte", NullType: "[]byte", JsonType: "[]byte", FmtType: "%x"},
+	"json":                        JSON_MAPPING,
+	"jsonb":                       JSON_MAPPING,
+	"date":                        TIME_MAPPING,
+	"timestamp without time zone": TIME_MAPPING,
+	"timestamp with time zone":    TIME_MAPPING,
+}
+
+// keyed by the type of the elements, ex text[] --> text, scanned and written
+// through the array types of pq
+var POSTGRES_ARRAY_MAPPINGS = map[string]GoTypeMapping{
+	"text":              STRING_ARRAY_MAPPING,
+	"character varying": STRING_ARRAY_MAPPING,
+	"character":         STRING_ARRAY_MAPPING,
+	"citext":            STRING_ARRAY_MAPPING,
+	"uuid":              STRING_ARRAY_MAPPING,
+	"numeric":           STRING_ARRAY_MAPPING,
+	"smallint":          INT_ARRAY_MAPPING,
+	"integer":           INT_ARRAY_MAPPING,
+	"bigint":            INT_ARRAY_MAPPING,
+	"real":              FLOAT_ARRAY_MAPPING,
+	"double precision":  FLOAT_ARRAY_MAPPING,
+	"boolean":           {Type: "pq.BoolArray", NullType: "pq.BoolArray", JsonType: "[]bool", FmtType: "%v", Import: PQ_IMPORT_PATH, IsArray: true},
+	"bytea":             {Type: "pq.ByteaArray", NullType: "pq.ByteaArray", JsonType: "[][]byte", FmtType: "%v", Import: PQ_IMPORT_PATH, IsArray: true},
+}
+
+var typeModifierRegexp = regexp.MustCompile(`\([^)]*\)`)
+
+func postgresTypeMapping(postgresType string) (GoTypeMapping, bool) {
+	name := strings.Join(strings.Fields(typeModifierRegexp.ReplaceAllString(postgresType, "")), " ")
+	if strings.HasSuffix(name, "[]") {
+		mapping, exists := POSTGRES_ARRAY_MAPPINGS[strings.TrimSuffix(name, "[]")]
+		return mapping, exists
+	}
+	mapping, exists := POSTGRES_TYPE_MAPPINGS[name]
+	return mapping, exists
+}
+
+// columnTypeMapping is postgresTypeMapping knowing the enums of the schema, an
+// enum is held as its label
+func columnTypeMapping(column *Column) (GoTypeMapping, bool) {
+	if column.IsEnum && strings.HasSuffix(column.Type, "[]") {
+		return STRING_ARRAY_MAPPING, true
+	}
+	if column.IsEnum {
+		return STRING_MAPPING, true
+	}
+	return postgresTypeMapping(column.Type)
+}
+
+// isArrayType tells whether goType is one of the pq array types
+func isArrayType(goType string) bool {
+	for _, mapping := range POSTGRES_ARRAY_MAPPINGS {
+		if mapping.Type == goType {
+			return true
+		}
+	}
+	return false
+}
+
+func postgresToGoType(postgresType string) string {
+	if mapping, exists := postgresTypeMapping(postgresType); exists {
+		return mapping.Type
+	}
+	return "UNKNOW : " + postgresType
+}
+
+func postgresToFmtType(postgresType string) string {
+	if mapping, exists := postgresTypeMapping(postgresType); exists {
+		return mapping.FmtType
+	}
+	return "UNKNOW : " + postgresType
+}
+
+// isNullWrapped tells whether the entity holds a nullable column in a sql.Null type
+func isNullWrapped(column *Column) bool {
+	mapping, exists := columnTypeMapping(column)
+	return column.GoType == "" && exists && column.IsNullable && mapping.NullField != ""
+}
+
+// isIntegerColumn tells whether the entity holds the column in a go integer
+func isIntegerColumn(column *Column) bool {
+	goType := columnGoType(column)
+	return goType == "int" || goType == "int16" || goType == "int64"
+}
+
+// isTimestampColumn tells whether the entity holds the column in a time.Time
+func isTimestampColumn(column *Column) bool {
+	return columnGoType(column) == TIME_MAPPING.Type && strings.HasPrefix(column.Type, "timestamp")
+}
+
+// columnGoType is the type of the column in the entity
+func columnGoType(column *Column) string {
+	if column.GoType != "" {
+		expression, _, _ := parseGoType(column.GoType)
+		return expression
+	}
+	mapping, exists := columnTypeMapping(column)
+	if !exists {
+		return postgresToGoType(column.Type)
+	}
+	if column.IsNullable {
+		return mapping.NullType
+	}
+	return mapping.Type
+}
+
+// columnValueType is the type of a value compared to the column, the entity
+// type without its sql.Null wrapper, ex sql.NullInt32 --> int
+func columnValueType(column *Column) string {
+	if isNullWrapped(column) {
+		mapping, _ := columnTypeMapping(column)
+		return mapping.Type
+	}
+	return columnGoType(column)
+}
+
+// columnJsonType is the type of the column in the json struct, nil is null
+func columnJsonType(column *Column) string {
+	mapping, exists := columnTypeMapping(column)
+	if column.GoType != "" || !exists {
+		return columnGoType(column)
+	}
+	if column.IsNullable && !strings.HasPrefix(mapping.JsonType, "[]") && mapping.JsonType != JSON_MAPPING.JsonType {
+		return "*" + mapping.JsonType
+	}
+	return mapping.JsonType
+}
+
+func columnFmtType(column *Column) string {
+	if column.GoType != "" || column.IsNullable {
+		return "%v"
+	}
+	if mapping, exists := columnTypeMapping(column); exists {
+		return mapping.FmtType
+	}
+	return postgresToFmtType(column.Type)
+}
+
+// entityImports returns the packages the entity types of columns need, sorted
+// and without the ones the file already imports
+func entityImports(columns []*Column, imported ...string) []string {
+	seen := make(map[string]bool)
+	for _, importPath := range imported {
+		seen[importPath] = true
+	}
+	result := goTypeImports(columns, imported...)
+	for _, importPath := range result {
+		seen[importPath] = true
+	}
+	for _, column := range columns {
+		mapping, exists := columnTypeMapping(column)
+		if column.GoType != "" || !exists {
+			continue
+		}
+		importPath := mapping.Import
+		if isNullWrapped(column) {
+			importPath = "database/sql"
+		}
+		if importPath != "" && !seen[importPath] {
+			seen[importPath] = true
+			result = append(result, importPath)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// valueImports returns the packages the columnValueType of columns need,
+// sorted and without the ones the file already imports
+func valueImports(columns []*Column, imported ...string) []string {
+	seen := make(map[string]bool)
+	for _, importPath := range imported {
+		seen[importPath] = true
+	}
+	result := goTypeImports(columns, imported...)
+	for _, importPath := range result {
+		seen[importPath] = true
+	}
+	for _, column := range columns {
+		mapping, exists := columnTypeMapping(column)
+		if column.GoType != "" || !exists {
+			continue
+		}
+		if mapping.Import != "" && !seen[mapping.Import] {
+			seen[mapping.Import] = true
+			result = append(result, mapping.Import)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
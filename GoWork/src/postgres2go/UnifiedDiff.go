@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const DIFF_CONTEXT = 3
+
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	line string
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return make([]string, 0, 0)
+	}
+	lines := strings.Split(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines computes a minimal line edit script with a longest common subsequence
+func diffLines(oldLines []string, newLines []string) []diffOp {
+	prefix := 0
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix && oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+	a := oldLines[prefix : len(oldLines)-suffix]
+	b := newLines[prefix : len(newLines)-suffix]
+
+	// lcs[i][j] is the lcs length of a[i:] and b[j:]
+	width := len(b) + 1
+	lcs := make([]int32, (len(a)+1)*width)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i*width+j] = lcs[(i+1)*width+j+1] + 1
+			} else if lcs[(i+1)*width+j] >= lcs[i*width+j+1] {
+				lcs[i*width+j] = lcs[(i+1)*width+j]
+			} else {
+				lcs[i*width+j] = lcs[i*width+j+1]
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, len(oldLines)+len(newLines))
+	for _, line := range oldLines[:prefix] {
+		ops = append(ops, diffOp{' ', line})
+	}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if a[i] == b[j] {
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		} else if lcs[(i+1)*width+j] >= lcs[i*width+j+1] {
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		} else {
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{'+', b[j]})
+	}
+	for _, line := range oldLines[len(oldLines)-suffix:] {
+		ops = append(ops, diffOp{' ', line})
+	}
+	return ops
+}
+
+func hunkRange(start int, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// unifiedDiff returns the diff -u output between two texts, empty when they are equal
+func unifiedDiff(oldName string, newName string, oldText string, newText string) string {
+	if oldText == newText {
+		return ""
+	}
+	ops := diffLines(splitLines(oldText), splitLines(newText))
+
+	// number of old and new lines before each op
+	oldBefore := make([]int, len(ops)+1)
+	newBefore := make([]int, len(ops)+1)
+	for index, op := range ops {
+		oldBefore[index+1] = oldBefore[index]
+		newBefore[index+1] = newBefore[index]
+		if op.kind != '+' {
+			oldBefore[index+1]++
+		}
+		if op.kind != '-' {
+			newBefore[index+1]++
+		}
+	}
+
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "--- %s\n+++ %s\n", oldName, newName)
+	index := 0
+	for index < len(ops) {
+		if ops[index].kind == ' ' {
+			index++
+			continue
+		}
+		start := index - DIFF_CONTEXT
+		if start < 0 {
+			start = 0
+		}
+		lastChange := index
+		for next := index; next < len(ops) && next-lastChange <= 2*DIFF_CONTEXT; next++ {
+			if ops[next].kind != ' ' {
+				lastChange = next
+			}
+		}
+		stop := lastChange + DIFF_CONTEXT + 1
+		if stop > len(ops) {
+			stop = len(ops)
+		}
+		oldStart := oldBefore[start]
+		newStart := newBefore[start]
+		fmt.Fprintf(&buffer, "@@ -%s +%s @@\n", hunkRange(oldStart, oldBefore[stop]-oldStart), hunkRange(newStart, newBefore[stop]-newStart))
+		for _, op := range ops[start:stop] {
+			buffer.WriteByte(op.kind)
+			buffer.WriteString(op.line)
+			buffer.WriteByte('\n')
+		}
+		index = stop
+	}
+	return buffer.String()
+}
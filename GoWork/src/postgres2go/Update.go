@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"github.com/lib/pq"
+	"io"
+	"strings"
+)
+
+// the columns of the optimistic locking found without configuration
+const VERSION_COLUMN_NAME = "version"
+const UPDATED_AT_COLUMN_NAME = "updated_at"
+
+// isVersionColumn tells whether column can hold the version of a row : an
+// integer bumped by the update or a timestamp set to now()
+func isVersionColumn(column *Column) bool {
+	return !column.IsPrimary && !column.IsNullable && (isIntegerColumn(column) || isTimestampColumn(column))
+}
+
+// isUpdateParam tells whether update<Entity> writes column from the entity :
+// not the primary key, read only, version or audit columns
+func (table *Table) isUpdateParam(column *Column) bool {
+	return !column.IsPrimary && !column.ReadOnly && column != table.versionColumn() && !table.isAuditTimestamp(column)
+}
+
+// hasUpdate tells whether update<Entity> is generated : the table has a
+// single column key and a column to write or a version to bump
+func (table *Table) hasUpdate() bool {
+	hasKey := false
+	hasParam := false
+	for _, column := range table.goColumns() {
+		hasKey = hasKey || column.IsPrimary
+		hasParam = hasParam || table.isUpdateParam(column)
+	}
+	return hasKey && (hasParam || table.versionColumn() != nil)
+}
+
+// initialVersion returns the value the inserts give to the version column,
+// 1 or now(), empty for the other columns and a version the audit already sets
+func (table *Table) initialVersion(column *Column) string {
+	if column != table.versionColumn() || column.ReadOnly || table.isAuditTimestamp(column) {
+		return ""
+	}
+	if isIntegerColumn(column) {
+		return "1"
+	}
+	return "now()"
+}
+
+// writeUpdate writes update<Entity>, setting the columns of the row from
+// the entity and updated_at to now(), with a version column the row must not
+// have changed since the entity was read
+func writeUpdate(writer io.Writer, layout *OutputLayout, table *Table, names *TableNames, columns []*Column) {
+	if !table.hasUpdate() {
+		return
+	}
+	version := table.versionColumn()
+	assignments := make([]string, 0, len(columns))
+	conditions := make([]string, 0, 0)
+	arguments := make([]string, 0, len(columns))
+	for i, column := range columns {
+		if !table.isUpdateParam(column) {
+			continue
+		}
+		arguments = append(arguments, "entity."+names.Fields[i])
+		assignments = append(assignments, fmt.Sprintf("%s=$%d", pq.QuoteIdentifier(column.Name), len(arguments)))
+	}
+	for i, column := range columns {
+		if column.IsPrimary {
+			arguments = append(arguments, "entity."+names.Fields[i])
+			conditions = append(conditions, fmt.Sprintf("%s=$%d", pq.QuoteIdentifier(column.Name), len(arguments)))
+		}
+	}
+	// created_at is left as inserted
+	updatedAt := table.updatedAtColumn()
+	if updatedAt != nil && updatedAt != version && table.isAuditTimestamp(updatedAt) {
+		assignments = append(assignments, pq.QuoteIdentifier(updatedAt.Name)+"=now()")
+	}
+	noRowError := notFoundErrorName(names)
+	if version != nil {
+		for i, column := range columns {
+			if column == version {
+				arguments = append(arguments, "entity."+names.Fields[i])
+			}
+		}
+		versionName := pq.QuoteIdentifier(version.Name)
+		conditions = append(conditions, fmt.Sprintf("%s=$%d", versionName, len(arguments)))
+		if isIntegerColumn(version) {
+			assignments = append(assignments, versionName+"="+versionName+"+1")
+		} else {
+			assignments = append(assignments, versionName+"=now()")
+		}
+		noRowError = "ErrStaleEntity"
+	}
+	entityType := layout.qualifier(KIND_DAO, KIND_MODEL) + names.Entity
+	updateFunc := layout.funcName("update" + names.Entity)
+
+	if version != nil {
+		fmt.Fprintf(writer, "// %s writes entity to its row and returns the row as updated, ErrStaleEntity\n", updateFunc)
+		fmt.Fprintf(writer, "// when %s changed since entity was read or the row is gone\n", version.Name)
+	} else {
+		fmt.Fprintf(writer, "// %s writes entity to its row and returns the row as updated\n", updateFunc)
+	}
+	fmt.Fprintf(writer, "func %s(ctx context.Context, q DBTX, entity *%s) (*%s, error) {\n", updateFunc, entityType, entityType)
+	query := fmt.Sprintf("update %s set %s where %s returning %s", pq.QuoteIdentifier(table.Name), strings.Join(assignments, ","), strings.Join(conditions, " and "), sqlColumnList(columns))
+	fmt.Fprintf(writer, "\trow := q.QueryRowContext(ctx, %q, %s)\n", query, strings.Join(arguments, ", "))
+	fmt.Fprintf(writer, "\tresult, err := rowResultSetTo%s(row)\n", names.Entity)
+	fmt.Fprintf(writer, "\tif errors.Is(err, sql.ErrNoRows) {\n")
+	fmt.Fprintf(writer, "\t\treturn nil, %s\n", noRowError)
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\tif err != nil {\n")
+	fmt.Fprintf(writer, "\t\treturn nil, %s(err)\n", mapErrorFuncName(names))
+	fmt.Fprintf(writer, "\t}\n")
+	fmt.Fprintf(writer, "\treturn result, nil\n")
+	fmt.Fprintf(writer, "}\n\n")
+}
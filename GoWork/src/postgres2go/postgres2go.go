@@ -4,12 +4,14 @@ import (
 	"bufio"
 	"bytes"
 	"database/sql" // package SQL
-	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
-	_ "github.com/lib/pq" // driver Postgres
+	"github.com/lib/pq" // driver Postgres
 	"io/ioutil"
 	"os"
 	"strings"
+	"unicode"
 )
 
 const (
@@ -19,156 +21,543 @@ const (
 	STATUS_UNKNOW   = 3
 )
 
+const (
+	COMMAND_GENERATE = "generate"
+	COMMAND_CHECK    = "check"
+	COMMAND_INSPECT  = "inspect"
+	COMMAND_CONFIG   = "config"
+)
+
+const (
+	CONFIG_COMMAND_VALIDATE = "validate"
+	CONFIG_COMMAND_INIT     = "init"
+)
+
+const FORMAT_JSON = "json"
+
+var console = NewRedactWriter(os.Stdout)
+
+type Postgres2GoOptions struct {
+	configFileName  string
+	outputDirectory string
+	packageName     string
+	layoutName      string
+	importPath      string
+	fileNaming      string
+	dryRun          bool
+	prune           bool
+	fromSnapshot    string
+	fromDDL         string
+	format          string
+	snapshotOutput  string
+	configCommand   string
+	configFormat    string
+	configOutput    string
+}
+
 func main() {
 	message := "UNKNOW - "
 	status := STATUS_UNKNOW
 
+	command := COMMAND_GENERATE
+	args := os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		command = args[0]
+		args = args[1:]
+	}
+	configCommand := ""
+	if command == COMMAND_CONFIG && len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		configCommand = args[0]
+		args = args[1:]
+	}
+
 	workingDirectory := "./"
-	postgresConfigFullFileName := "./" + "postgres-to-go.config"
+	options := new(Postgres2GoOptions)
+	options.configCommand = configCommand
+	flags := flag.NewFlagSet("postgres2go "+command, flag.ExitOnError)
+	flags.StringVar(&options.configFileName, "config", "./"+"postgres-to-go.config", "configuration file, json, or yaml and toml with a .yaml, .yml or .toml extension")
+	flags.StringVar(&options.outputDirectory, "output", "", "output directory (default ./outputs)")
+	flags.StringVar(&options.packageName, "package", "", "go package name of generated files with the flat layout (default main)")
+	flags.StringVar(&options.layoutName, "layout", "", "output layout : flat or split into model/, dto/ and dao/ sub packages (default flat)")
+	flags.StringVar(&options.importPath, "import-path", "", "go import path of the output directory, required by the split layout")
+	flags.StringVar(&options.fileNaming, "file-naming", "", "generated file names : camel (UserAccount.go) or snake (user_account.go) (default camel)")
+	flags.BoolVar(&options.dryRun, "dry-run", false, "show the files "+COMMAND_GENERATE+" would create or change, with a unified diff, without writing them")
+	flags.BoolVar(&options.prune, "prune", true, "delete the files of the previous generation that are no longer produced, with -prune=false they are only listed")
+	flags.StringVar(&options.fromSnapshot, "from-snapshot", "", "read the schema from a json snapshot written by "+COMMAND_INSPECT+" instead of the database")
+	flags.StringVar(&options.fromDDL, "from-ddl", "", "read the schema from the .sql migration files of a directory instead of the database")
+	// -format and -o name what the command writes, a snapshot or a config
+	switch {
+	case command == COMMAND_INSPECT:
+		flags.StringVar(&options.format, "format", FORMAT_JSON, "snapshot format : "+FORMAT_JSON)
+		flags.StringVar(&options.snapshotOutput, "o", "", "snapshot file written (default standard output)")
+	case command == COMMAND_CONFIG && configCommand == CONFIG_COMMAND_INIT:
+		flags.StringVar(&options.configFormat, "format", "", "config format : "+CONFIG_FORMAT_YAML+" or "+CONFIG_FORMAT_TOML+" (default from the -o extension, else "+CONFIG_FORMAT_YAML+")")
+		flags.StringVar(&options.configOutput, "o", "", "config file written, an existing file is not overwritten (default standard output)")
+	}
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "usage : postgres2go [%s|%s|%s|%s %s|%s %s] [flags]\n", COMMAND_GENERATE, COMMAND_CHECK, COMMAND_INSPECT, COMMAND_CONFIG, CONFIG_COMMAND_VALIDATE, COMMAND_CONFIG, CONFIG_COMMAND_INIT)
+		fmt.Fprintf(flags.Output(), "  %s\twrite generated files (default)\n", COMMAND_GENERATE)
+		fmt.Fprintf(flags.Output(), "  %s\tcompare generated files with the ones on disk, exit %d when they differ\n", COMMAND_CHECK, STATUS_WARNING)
+		fmt.Fprintf(flags.Output(), "  %s\twrite a json snapshot of the schema, usable offline with -from-snapshot\n", COMMAND_INSPECT)
+		fmt.Fprintf(flags.Output(), "  %s %s\tcheck the configuration file without connecting to the database\n", COMMAND_CONFIG, CONFIG_COMMAND_VALIDATE)
+		fmt.Fprintf(flags.Output(), "  %s %s\twrite a commented default configuration\n", COMMAND_CONFIG, CONFIG_COMMAND_INIT)
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
 	logFullFileName := workingDirectory + "/postgres-to-go.log"
 	logFileHandle, err := os.Create(logFullFileName)
 	if err != nil {
-		fmt.Println(err)
+		fmt.Fprintln(console, err)
 		message = fmt.Sprintf("CRITICAL - can not create log file %s", logFullFileName)
 		status = STATUS_CRITICAL
-		fmt.Printf("%s\n", message)
+		fmt.Fprintf(console, "%s\n", message)
+		console.Flush()
 		os.Exit(status)
 	}
 	defer logFileHandle.Close()
-	logWriter := bufio.NewWriter(logFileHandle) // *bufio.Writer
+	logRedactWriter := NewRedactWriter(logFileHandle)
+	logWriter := bufio.NewWriter(logRedactWriter) // *bufio.Writer
 
-	dbinfo, err := readFile(postgresConfigFullFileName)
-	if err == nil {
-		postgresToGoConfig := new(PostgresToGoConfig)
-		errUnmarshal := json.Unmarshal([]byte(dbinfo), postgresToGoConfig)
-		if errUnmarshal == nil {
-			db, err := sql.Open("postgres", postgresToGoConfig.dbPostgresConnectString())
-			if err == nil {
-				err = db.Ping()
-				if err == nil {
-					fmt.Fprintln(logWriter, "Connected to "+postgresToGoConfig.Db)
-					fmt.Println("Connected to " + postgresToGoConfig.Db)
-					defer db.Close()
-					
-					if err == nil {
-						fmt.Fprintln(logWriter, "Describe tables")
-						fmt.Println("Describe tables")
-						tableList := readTableList(db)
-						tables := make([]*Table, 0, 0)
-						for _, tableName := range tableList {
-							table, err := descTable(logWriter, db, tableName)
-							if err != nil {
-								fmt.Fprintln(logWriter, err)
-								fmt.Println(err)
-							}
-							if nil != table {
-								tables = append(tables, table)
-							}
-						}
-						fmt.Fprintln(logWriter, "Generate Primary Keys")
-						fmt.Println("Generate Primary Keys")
-						for _, table := range tables {
-							table.generatePrimaryKeyConstraint()
-						}
-
-						fmt.Fprintln(logWriter, "Generate Foreign Keys")
-						fmt.Println("Generate Foreign Keys")
-						for _, table := range tables {
-							table.generateForeignKeysConstraint(logWriter)
-						}
-
-						for _, table := range tables {
-							fmt.Printf("Table:%s --> %s\n", table.Name, snakeToCamel(table.Name))
-							fmt.Printf("\tJsonDataType ")
-							err := generateGoJsonMapping( table )
-							if err == nil {
-								fmt.Printf("Done\n")
-							} else {
-								fmt.Printf("%+v\n",err)
-							}
-
-							fmt.Printf("\tEntity ")
-							err = generateGoEntity( table )
-							if err == nil {
-								fmt.Printf("Done\n")
-							} else {
-								fmt.Printf("%+v\n",err)
-							}
-
-							fmt.Printf("\tDAO ")
-							err = generateGoEntityDAO( table )
-							if err == nil {
-								fmt.Printf("Done\n")
-							} else {
-								fmt.Printf("%+v\n",err)
-							}
-						}
-
-					} else {
-						message = fmt.Sprintf("CRITICAL - can not connect to postgres database %s", err.Error())
-						status = STATUS_CRITICAL
-					}
-				} else {
-					message = fmt.Sprintf("CRITICAL - can not connect to postgres database %s", err.Error())
-					status = STATUS_CRITICAL
-				}
-			} else {
-				message = fmt.Sprintf("CRITICAL - can not parse json config file %s", errUnmarshal.Error())
-				status = STATUS_CRITICAL
+	switch command {
+	case COMMAND_GENERATE:
+		if options.dryRun {
+			status, message = runDryRun(logWriter, options)
+		} else {
+			status, message = runGenerate(logWriter, options)
+		}
+	case COMMAND_CHECK:
+		status, message = runCheck(logWriter, options)
+	case COMMAND_INSPECT:
+		if options.snapshotOutput == "" {
+			// stdout carries the snapshot, progress goes to stderr
+			console.Flush()
+			console = NewRedactWriter(os.Stderr)
+		}
+		status, message = runInspect(logWriter, options)
+	case COMMAND_CONFIG:
+		switch options.configCommand {
+		case CONFIG_COMMAND_VALIDATE:
+			status, message = runConfigValidate(logWriter, options)
+		case CONFIG_COMMAND_INIT:
+			if options.configOutput == "" {
+				// stdout carries the config, the status goes to stderr
+				console.Flush()
+				console = NewRedactWriter(os.Stderr)
 			}
+			status, message = runConfigInit(logWriter, options)
+		default:
+			flags.Usage()
+			message = fmt.Sprintf("UNKNOW - unknown command %s %s, expected %s or %s", COMMAND_CONFIG, options.configCommand, CONFIG_COMMAND_VALIDATE, CONFIG_COMMAND_INIT)
+			status = STATUS_UNKNOW
+		}
+	default:
+		flags.Usage()
+		message = fmt.Sprintf("UNKNOW - unknown command %s", command)
+		status = STATUS_UNKNOW
+	}
+
+	fmt.Fprintf(logWriter, "%s\n", message)
+	fmt.Fprintf(console, "%s\n", message)
+	logWriter.Flush()
+	logRedactWriter.Flush()
+	console.Flush()
+	os.Exit(status)
+}
+
+func loadConfig(options *Postgres2GoOptions) (*PostgresToGoConfig, error) {
+	dbinfo, err := readFile(options.configFileName)
+	if err != nil {
+		return nil, fmt.Errorf("can not read postgres config file : %s", options.configFileName)
+	}
+	postgresToGoConfig, err := parseConfig(configFormat(options.configFileName), dbinfo)
+	if err != nil {
+		return nil, fmt.Errorf("can not parse %s config file %s : %s", configFormat(options.configFileName), options.configFileName, err.Error())
+	}
+	if options.outputDirectory != "" {
+		postgresToGoConfig.Output.Directory = options.outputDirectory
+	}
+	if options.packageName != "" {
+		postgresToGoConfig.Output.PackageName = options.packageName
+	}
+	if options.layoutName != "" {
+		postgresToGoConfig.Output.Layout = options.layoutName
+	}
+	if options.importPath != "" {
+		postgresToGoConfig.Output.ImportPath = options.importPath
+	}
+	if options.fileNaming != "" {
+		postgresToGoConfig.Output.FileNaming = options.fileNaming
+	}
+	return postgresToGoConfig, nil
+}
+
+func connectDatabase(logWriter *bufio.Writer, options *Postgres2GoOptions, postgresToGoConfig *PostgresToGoConfig) (*sql.DB, error) {
+	connectString, err := postgresToGoConfig.dbPostgresConnectString()
+	if err != nil {
+		return nil, fmt.Errorf("invalid config file %s : %s", options.configFileName, redactError(err).Error())
+	}
+	db, err := sql.Open("postgres", connectString)
+	if err == nil {
+		err = db.Ping()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can not connect to postgres database %s", redactError(err).Error())
+	}
+	fmt.Fprintln(logWriter, "Connected to "+postgresToGoConfig.Db)
+	fmt.Fprintln(console, "Connected to "+postgresToGoConfig.Db)
+	return db, nil
+}
+
+// openIntrospector reads the schema from the snapshot given with -from-snapshot,
+// the migrations given with -from-ddl, or from the database
+func openIntrospector(logWriter *bufio.Writer, options *Postgres2GoOptions, postgresToGoConfig *PostgresToGoConfig) (Introspector, error) {
+	if options.fromSnapshot != "" && options.fromDDL != "" {
+		return nil, errors.New("-from-snapshot and -from-ddl can not be used together")
+	}
+	if options.fromDDL != "" {
+		fmt.Fprintln(logWriter, "Parse ddl "+options.fromDDL)
+		fmt.Fprintln(console, "Parse ddl "+options.fromDDL)
+		return NewDDLIntrospector(logWriter, options.fromDDL)
+	}
+	if options.fromSnapshot != "" {
+		fmt.Fprintln(logWriter, "Read snapshot "+options.fromSnapshot)
+		fmt.Fprintln(console, "Read snapshot "+options.fromSnapshot)
+		return NewSnapshotIntrospector(options.fromSnapshot)
+	}
+	db, err := connectDatabase(logWriter, options, postgresToGoConfig)
+	if err != nil {
+		return nil, err
+	}
+	return NewCatalogIntrospector(db, postgresToGoConfig.Db), nil
+}
+
+func loadSchema(logWriter *bufio.Writer, options *Postgres2GoOptions, postgresToGoConfig *PostgresToGoConfig) (*Schema, error) {
+	introspector, err := openIntrospector(logWriter, options, postgresToGoConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer introspector.close()
+	return readSchema(logWriter, introspector)
+}
+
+func generateTables(layout *OutputLayout, sink OutputSink, tables []*Table) int {
+	failures := 0
+	layout.naming.assignEntityNames(tables)
+	fmt.Fprintf(console, "DAO support ")
+	err := generateDaoSupport(layout, sink)
+	if err == nil {
+		err = generateFakeDatabase(layout, sink)
+	}
+	if err == nil {
+		fmt.Fprintf(console, "Done\n")
+	} else {
+		fmt.Fprintf(console, "%+v\n", err)
+		failures++
+	}
+	for _, table := range tables {
+		fmt.Fprintf(console, "Table:%s --> %s\n", table.Name, layout.naming.entityName(table))
+		fmt.Fprintf(console, "\tJsonDataType ")
+		err = generateGoJsonMapping(layout, sink, table)
+		if err == nil {
+			fmt.Fprintf(console, "Done\n")
 		} else {
-			message = fmt.Sprintf("CRITICAL - can not read postgres config file : %s", postgresConfigFullFileName)
-			status = STATUS_CRITICAL
+			fmt.Fprintf(console, "%+v\n", err)
+			failures++
+		}
+
+		fmt.Fprintf(console, "\tEntity ")
+		err = generateGoEntity(layout, sink, table)
+		if err == nil {
+			fmt.Fprintf(console, "Done\n")
+		} else {
+			fmt.Fprintf(console, "%+v\n", err)
+			failures++
+		}
+
+		fmt.Fprintf(console, "\tDAO ")
+		err = generateGoEntityDAO(layout, sink, table)
+		if err == nil {
+			fmt.Fprintf(console, "Done\n")
+		} else {
+			fmt.Fprintf(console, "%+v\n", err)
+			failures++
+		}
+
+		fmt.Fprintf(console, "\tRepository ")
+		err = generateGoRepository(layout, sink, table)
+		if err == nil {
+			err = generateGoRepositoryMock(layout, sink, table)
+		}
+		if err == nil {
+			err = generateGoFakeRepository(layout, sink, table)
+		}
+		if err == nil {
+			fmt.Fprintf(console, "Done\n")
+		} else {
+			fmt.Fprintf(console, "%+v\n", err)
+			failures++
+		}
+
+		fmt.Fprintf(console, "\tQuery ")
+		err = generateGoQuery(layout, sink, table)
+		if err == nil {
+			fmt.Fprintf(console, "Done\n")
+		} else {
+			fmt.Fprintf(console, "%+v\n", err)
+			failures++
 		}
-		fmt.Fprintf(logWriter, "%s\n", message)
-		fmt.Printf("%s\n", message)
-		os.Exit(status)
 	}
+	return failures
 }
 
-func postgresToGoType(postgresType string) string {
-	if postgresType == "text" {
-		return "string"
-	} else if postgresType == "integer" {
-		return "int"
-	} else if postgresType == "bigint" {
-		return "int64"
-	} else if postgresType == "double precision" {
-		return "float64"
-	}
-	return "UNKNOW : " + postgresType
+// runPipeline reads the schema and runs every generator into sink
+func runPipeline(logWriter *bufio.Writer, options *Postgres2GoOptions, sink OutputSink) (*OutputLayout, error) {
+	postgresToGoConfig, err := loadConfig(options)
+	if err != nil {
+		return nil, err
+	}
+	layout, err := NewOutputLayout(postgresToGoConfig.Output)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config file %s : %s", options.configFileName, err.Error())
+	}
+
+	schema, err := loadSchema(logWriter, options, postgresToGoConfig)
+	if err != nil {
+		return nil, err
+	}
+	err = applyTableOverrides(schema, postgresToGoConfig.Tables)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config file %s : %s", options.configFileName, err.Error())
+	}
+	failures := generateTables(layout, sink, schema.Tables)
+	if failures > 0 {
+		return layout, fmt.Errorf("%d generator(s) failed", failures)
+	}
+	return layout, nil
 }
 
-func postgresToFmtType(postgresType string) string {
-	if postgresType == "text" {
-		return "%s"
-	} else if postgresType == "integer" {
-		return "%d"
-	} else if postgresType == "bigint" {
-		return "%d"
-	} else if postgresType == "double precision" {
-		return "%f"
-	}
-	return "UNKNOW : " + postgresType
+func runInspect(logWriter *bufio.Writer, options *Postgres2GoOptions) (int, string) {
+	if options.format != FORMAT_JSON {
+		return STATUS_CRITICAL, fmt.Sprintf("CRITICAL - unsupported snapshot format %s, expected %s", options.format, FORMAT_JSON)
+	}
+	postgresToGoConfig, err := loadConfig(options)
+	if err != nil {
+		return STATUS_CRITICAL, "CRITICAL - " + err.Error()
+	}
+	schema, err := loadSchema(logWriter, options, postgresToGoConfig)
+	if err != nil {
+		return STATUS_CRITICAL, "CRITICAL - " + err.Error()
+	}
+	content, err := NewSchemaSnapshot(schema).marshal()
+	if err != nil {
+		return STATUS_CRITICAL, "CRITICAL - " + err.Error()
+	}
+	if options.snapshotOutput == "" {
+		os.Stdout.Write(content)
+	} else {
+		err = ioutil.WriteFile(options.snapshotOutput, content, 0644)
+		if err != nil {
+			return STATUS_CRITICAL, "CRITICAL - " + err.Error()
+		}
+	}
+	return STATUS_OK, fmt.Sprintf("OK - snapshot of %d table(s) and %d enum(s) written", len(schema.Tables), len(schema.Enums))
 }
 
-func generateGoJsonMapping(table *Table) error {
-	entityName := snakeToCamel(table.Name) + "Json"
-	entityFileName := "./outputs/" + entityName + ".go"
-	entityHandle, err := os.Create(entityFileName)
+// runConfigValidate checks everything that does not need the database
+func runConfigValidate(logWriter *bufio.Writer, options *Postgres2GoOptions) (int, string) {
+	postgresToGoConfig, err := loadConfig(options)
 	if err != nil {
-		return err
+		return STATUS_CRITICAL, "CRITICAL - " + err.Error()
+	}
+	err = postgresToGoConfig.validate()
+	if err == nil {
+		_, err = NewOutputLayout(postgresToGoConfig.Output)
 	}
-	defer entityHandle.Close()
-	entityWriter := bufio.NewWriter(entityHandle) // *bufio.Writer
-	fmt.Fprintf(entityWriter, "package main\n\n")
+	if err == nil {
+		for _, tableName := range sortedOverrideNames(postgresToGoConfig.Tables) {
+			err = postgresToGoConfig.Tables[tableName].validate()
+			if err != nil {
+				err = fmt.Errorf("tables override [%s] : %s", tableName, err.Error())
+				break
+			}
+		}
+	}
+	if err != nil {
+		return STATUS_CRITICAL, fmt.Sprintf("CRITICAL - invalid config file %s : %s", options.configFileName, err.Error())
+	}
+	return STATUS_OK, fmt.Sprintf("OK - config file %s is valid", options.configFileName)
+}
+
+func runConfigInit(logWriter *bufio.Writer, options *Postgres2GoOptions) (int, string) {
+	format := CONFIG_FORMAT_YAML
+	if options.configFormat != "" {
+		format = options.configFormat
+	} else if options.configOutput != "" && configFormat(options.configOutput) != CONFIG_FORMAT_JSON {
+		format = configFormat(options.configOutput)
+	}
+	content := ""
+	switch format {
+	case CONFIG_FORMAT_YAML:
+		content = CONFIG_TEMPLATE_YAML
+	case CONFIG_FORMAT_TOML:
+		content = CONFIG_TEMPLATE_TOML
+	case CONFIG_FORMAT_JSON:
+		return STATUS_CRITICAL, "CRITICAL - json does not allow comments, use -format " + CONFIG_FORMAT_YAML + " or " + CONFIG_FORMAT_TOML
+	default:
+		return STATUS_CRITICAL, fmt.Sprintf("CRITICAL - unsupported config format %s, expected %s or %s", format, CONFIG_FORMAT_YAML, CONFIG_FORMAT_TOML)
+	}
+	if options.configOutput == "" {
+		os.Stdout.Write([]byte(content))
+		return STATUS_OK, fmt.Sprintf("OK - default %s config written", format)
+	}
+	if _, err := os.Stat(options.configOutput); err == nil {
+		return STATUS_CRITICAL, fmt.Sprintf("CRITICAL - %s already exists, it is not overwritten", options.configOutput)
+	}
+	err := ioutil.WriteFile(options.configOutput, []byte(content), 0644)
+	if err != nil {
+		return STATUS_CRITICAL, "CRITICAL - " + err.Error()
+	}
+	fmt.Fprintf(logWriter, "%s %s\n", FILE_CREATE, options.configOutput)
+	return STATUS_OK, fmt.Sprintf("OK - default %s config written to %s", format, options.configOutput)
+}
+
+func runGenerate(logWriter *bufio.Writer, options *Postgres2GoOptions) (int, string) {
+	sink := NewFileSink()
+	layout, err := runPipeline(logWriter, options, sink)
+	if err != nil {
+		return STATUS_CRITICAL, "CRITICAL - " + err.Error()
+	}
+	counts := make(map[string]int)
+	for _, change := range sink.changes {
+		counts[change.Action]++
+		if change.Action != FILE_UNCHANGED {
+			fmt.Fprintf(logWriter, "%s %s\n", change.Action, change.FileName)
+		}
+	}
+
+	generatedFileNames := sink.fileNames()
+	staleChanges, err := pruneChanges(layout.outputDirectory(), generatedFileNames)
+	if err != nil {
+		return STATUS_CRITICAL, "CRITICAL - " + err.Error()
+	}
+	for _, change := range staleChanges {
+		if options.prune {
+			err = os.Remove(change.FileName)
+			if err != nil && !os.IsNotExist(err) {
+				return STATUS_CRITICAL, "CRITICAL - " + err.Error()
+			}
+			counts[FILE_DELETE]++
+			fmt.Fprintf(logWriter, "%s %s\n", FILE_DELETE, change.FileName)
+			fmt.Fprintf(console, "%s %s\n", FILE_DELETE, change.FileName)
+		} else {
+			// still ours, a later run with -prune can delete it
+			generatedFileNames = append(generatedFileNames, change.FileName)
+			fmt.Fprintf(logWriter, "stale %s\n", change.FileName)
+			fmt.Fprintf(console, "stale %s (kept, -prune=false)\n", change.FileName)
+		}
+	}
+	err = NewManifest(layout.outputDirectory(), generatedFileNames).write()
+	if err != nil {
+		return STATUS_CRITICAL, "CRITICAL - can not write manifest " + err.Error()
+	}
+	return STATUS_OK, fmt.Sprintf("OK - generation done, %d created, %d changed, %d unchanged, %d deleted", counts[FILE_CREATE], counts[FILE_CHANGE], counts[FILE_UNCHANGED], counts[FILE_DELETE])
+}
+
+// runDryRun generates into memory and shows what generate would do, nothing is written
+func runDryRun(logWriter *bufio.Writer, options *Postgres2GoOptions) (int, string) {
+	sink := NewMemorySink()
+	layout, err := runPipeline(logWriter, options, sink)
+	if err != nil {
+		return STATUS_CRITICAL, "CRITICAL - " + err.Error()
+	}
+	changes, err := sink.changes()
+	if err != nil {
+		return STATUS_CRITICAL, "CRITICAL - " + err.Error()
+	}
+	staleChanges, err := pruneChanges(layout.outputDirectory(), sink.fileNames)
+	if err != nil {
+		return STATUS_CRITICAL, "CRITICAL - " + err.Error()
+	}
+	counts := make(map[string]int)
+	for _, change := range changes {
+		counts[change.Action]++
+		if change.Action == FILE_UNCHANGED {
+			continue
+		}
+		fmt.Fprintf(console, "%s %s\n", change.Action, change.FileName)
+		if change.Action == FILE_CHANGE {
+			fmt.Fprint(console, unifiedDiff(change.FileName, change.FileName, change.OldContent, change.NewContent))
+		}
+	}
+	for _, change := range staleChanges {
+		if options.prune {
+			counts[FILE_DELETE]++
+			fmt.Fprintf(console, "%s %s\n", FILE_DELETE, change.FileName)
+		} else {
+			fmt.Fprintf(console, "stale %s (kept, -prune=false)\n", change.FileName)
+		}
+	}
+	return STATUS_OK, fmt.Sprintf("OK - dry run, %d to create, %d to change, %d unchanged, %d to delete", counts[FILE_CREATE], counts[FILE_CHANGE], counts[FILE_UNCHANGED], counts[FILE_DELETE])
+}
+
+// runCheck generates into memory and compares with the files on disk, nothing is written
+func runCheck(logWriter *bufio.Writer, options *Postgres2GoOptions) (int, string) {
+	sink := NewMemorySink()
+	layout, err := runPipeline(logWriter, options, sink)
+	if err != nil {
+		return STATUS_CRITICAL, "CRITICAL - " + err.Error()
+	}
+	changes, err := sink.changes()
+	if err != nil {
+		return STATUS_CRITICAL, "CRITICAL - " + err.Error()
+	}
+	staleChanges, err := pruneChanges(layout.outputDirectory(), sink.fileNames)
+	if err != nil {
+		return STATUS_CRITICAL, "CRITICAL - " + err.Error()
+	}
+	for _, change := range staleChanges {
+		fmt.Fprintln(logWriter, "Stale "+change.FileName)
+		fmt.Fprint(console, unifiedDiff(change.FileName, "/dev/null", change.OldContent, ""))
+	}
+	differences := len(staleChanges)
+	for _, change := range changes {
+		if change.Action != FILE_UNCHANGED {
+			differences++
+			fmt.Fprintln(logWriter, "Out of date "+change.FileName)
+			fmt.Fprint(console, unifiedDiff(change.FileName, change.FileName, change.OldContent, change.NewContent))
+		}
+	}
+	if differences > 0 {
+		return STATUS_WARNING, fmt.Sprintf("WARNING - %d generated file(s) out of date, run postgres2go %s", differences, COMMAND_GENERATE)
+	}
+	return STATUS_OK, fmt.Sprintf("OK - %d generated file(s) up to date", len(changes))
+}
+
+func generateGoJsonMapping(layout *OutputLayout, sink OutputSink, table *Table) error {
+	names := layout.naming.tableNames(table)
+	columns := table.goColumns()
+	entityName := names.Entity + "Json"
+	entityFileName := layout.fileName(KIND_DTO, entityName)
+	var entityBuffer bytes.Buffer
+	entityWriter := bufio.NewWriter(&entityBuffer) // *bufio.Writer
+	writeGeneratedHeader(entityWriter, layout, KIND_DTO, table)
 	//	fmt.Fprintf(entityWriter, "import (\n\t\"fmt\"\n)\n")
+	importPaths := jsonFileImports(layout, jsonColumns(columns))
+	if len(importPaths) > 0 {
+		fmt.Fprintf(entityWriter, "import (\n")
+		for _, importPath := range importPaths {
+			fmt.Fprintf(entityWriter, "\t\"%s\"\n", importPath)
+		}
+		fmt.Fprintf(entityWriter, ")\n\n")
+	}
+	writeUserCodeRegion(entityWriter, layout, USER_CODE_IMPORTS)
 	fmt.Fprintf(entityWriter, "type %s struct {\n",entityName)
 
 	maxNameWidth := 0
 	maxTypeWidth := 0
-	for _, column := range table.columns {
-		camelName := snakeToCamel(column.Name)
+	for i, column := range columns {
+		camelName := names.Fields[i]
 		if len(camelName) > maxNameWidth {
 			maxNameWidth = len(camelName)
 		}
@@ -177,35 +566,42 @@ func generateGoJsonMapping(table *Table) error {
 		}
 	}
 	maxNameWidth = maxNameWidth + 10 
-	for _, column := range table.columns {
-		camelName := snakeToCamel(column.Name)
-		goType := postgresToGoType(column.Type)		
-		camelFirstLowName := strings.ToLower(camelName[:1]) + camelName[1:]
-		fmt.Fprintf(entityWriter, "\t%-*s\t%-*s\t`json:\"%s,omitempty\"`\n", maxNameWidth, camelName, maxTypeWidth, goType, camelFirstLowName)
+	for i, column := range columns {
+		camelName := names.Fields[i]
+		if column.OmitFromJson {
+			continue
+		}
+		goType := columnJsonType(column)
+		fmt.Fprintf(entityWriter, "\t%-*s\t%-*s\t`json:\"%s,omitempty\"`\n", maxNameWidth, camelName, maxTypeWidth, goType, names.JsonNames[i])
 	}
 	fmt.Fprintf(entityWriter, "}\n\n")
+	writeJsonConversions(entityWriter, layout, names, columns)
+	writeUserCodeRegion(entityWriter, layout, USER_CODE_CODE)
 
 	entityWriter.Flush()
-	return nil
+	return sink.writeFile(entityFileName, entityBuffer.Bytes())
 }
 
-func generateGoEntity(table *Table) error {
-	entityName := snakeToCamel(table.Name)
-	entityFileName := "./outputs/" + entityName + ".go"
-	entityHandle, err := os.Create(entityFileName)
-	if err != nil {
-		return err
+func generateGoEntity(layout *OutputLayout, sink OutputSink, table *Table) error {
+	names := layout.naming.tableNames(table)
+	columns := table.goColumns()
+	entityName := names.Entity
+	entityFileName := layout.fileName(KIND_MODEL, entityName)
+	var entityBuffer bytes.Buffer
+	entityWriter := bufio.NewWriter(&entityBuffer) // *bufio.Writer
+	writeGeneratedHeader(entityWriter, layout, KIND_MODEL, table)
+	fmt.Fprintf(entityWriter, "import (\n\t\"fmt\"\n")
+	for _, importPath := range entityImports(columns, "fmt") {
+		fmt.Fprintf(entityWriter, "\t\"%s\"\n", importPath)
 	}
-	defer entityHandle.Close()
-	entityWriter := bufio.NewWriter(entityHandle) // *bufio.Writer
-	fmt.Fprintf(entityWriter, "package main\n\n")
-	fmt.Fprintf(entityWriter, "import (\n\t\"fmt\"\n)\n")
+	fmt.Fprintf(entityWriter, ")\n")
+	writeUserCodeRegion(entityWriter, layout, USER_CODE_IMPORTS)
 	fmt.Fprintf(entityWriter, "type %s struct {\n",entityName)
 
 	maxNameWidth := 0
 	maxTypeWidth := 0
-	for _, column := range table.columns {
-		camelName := snakeToCamel(column.Name)
+	for i, column := range columns {
+		camelName := names.Fields[i]
 		if len(camelName) > maxNameWidth {
 			maxNameWidth = len(camelName)
 		}
@@ -214,19 +610,18 @@ func generateGoEntity(table *Table) error {
 		}
 	}
 	maxNameWidth = maxNameWidth + 10 
-	for _, column := range table.columns {
-		camelName := snakeToCamel(column.Name)
-		goType := postgresToGoType(column.Type)		
+	for i, column := range columns {
+		camelName := names.Fields[i]
+		goType := columnGoType(column)		
 		fmt.Fprintf(entityWriter, "\t%-*s\t%-*s\n", maxNameWidth, camelName, maxTypeWidth, goType)
 	}
 	fmt.Fprintf(entityWriter, "}\n\n")
 
 	fmt.Fprintf(entityWriter, "func New%s(",entityName)
 	waitSemilicon := false
-	for _, column := range table.columns {
-		camelName := snakeToCamel(column.Name)
-		goType := postgresToGoType(column.Type)
-		camelFirstLowName := strings.ToLower(camelName[:1]) + camelName[1:]
+	for i, column := range columns {
+		goType := columnGoType(column)
+		camelFirstLowName := names.Params[i]
 		if waitSemilicon == false {
 			fmt.Fprintf(entityWriter, "%s %s", camelFirstLowName, goType)
 		} else {
@@ -236,10 +631,10 @@ func generateGoEntity(table *Table) error {
 	}
 	fmt.Fprintf(entityWriter, ") *%s {\n",entityName)
 	fmt.Fprintf(entityWriter, "\treturn &%s{\n",entityName)
-	for i, column := range table.columns {
-		camelName := snakeToCamel(column.Name)
-		camelFirstLowName := strings.ToLower(camelName[:1]) + camelName[1:]
-		if i < len(table.columns) - 1 {
+	for i := range columns {
+		camelName := names.Fields[i]
+		camelFirstLowName := names.Params[i]
+		if i < len(columns) - 1 {
 			nameToPrint := camelName + ":"
 			nameFirstLowToPrint := camelFirstLowName + ","
 			fmt.Fprintf(entityWriter, "\t\t%-*s\t%-*s\n", maxNameWidth, nameToPrint, maxNameWidth, nameFirstLowToPrint)
@@ -253,43 +648,57 @@ func generateGoEntity(table *Table) error {
 
 	fmt.Fprintf(entityWriter, "func (d *%s) String() string {\n",entityName)
 	fmt.Fprintf(entityWriter, "\treturn fmt.Sprintf(\"%s",entityName)
-	for _, column := range table.columns {
-		camelName := snakeToCamel(column.Name)
-		fmtType := postgresToFmtType(column.Type)
+	for i, column := range columns {
+		camelName := names.Fields[i]
+		fmtType := columnFmtType(column)
 		fmt.Fprintf(entityWriter, " %s(%s)",camelName, fmtType)		
 	}
 	fmt.Fprintf(entityWriter, ")\"")
-	for _, column := range table.columns {
-		camelName := snakeToCamel(column.Name)
+	for i := range columns {
+		camelName := names.Fields[i]
 		fmt.Fprintf(entityWriter, ", d.%s",camelName)		
 	}
 	fmt.Fprintf(entityWriter, ")\n")
 	fmt.Fprintf(entityWriter, "}\n\n")
-	
+	writeUserCodeRegion(entityWriter, layout, USER_CODE_CODE)
+
 	entityWriter.Flush()
-	return nil
+	return sink.writeFile(entityFileName, entityBuffer.Bytes())
 }
 
-func generateGoEntityDAO(table *Table) error {
+func generateGoEntityDAO(layout *OutputLayout, sink OutputSink, table *Table) error {
 	var bufferVars bytes.Buffer
 	var bufferScan bytes.Buffer
 	var bufferNew bytes.Buffer
-	entityName := snakeToCamel(table.Name)
-	entityFileName := "./outputs/" + entityName + "DAO.go"
-	entityHandle, err := os.Create(entityFileName)
-	if err != nil {
-		return err
+	names := layout.naming.tableNames(table)
+	columns := table.goColumns()
+	entityName := names.Entity
+	entityType := layout.qualifier(KIND_DAO, KIND_MODEL) + entityName
+	entityConstructor := layout.qualifier(KIND_DAO, KIND_MODEL) + "New" + entityName
+	entityFileName := layout.fileName(KIND_DAO, entityName+"DAO")
+	var entityBuffer bytes.Buffer
+	entityWriter := bufio.NewWriter(&entityBuffer) // *bufio.Writer
+	writeGeneratedHeader(entityWriter, layout, KIND_DAO, table)
+	imported := []string{"context", "database/sql", "errors", "strconv"}
+	if bulkInsertsUseTime(table, names, columns) {
+		imported = append(imported, "time")
+	}
+	fmt.Fprintf(entityWriter, "import (\n")
+	for _, importPath := range imported {
+		fmt.Fprintf(entityWriter, "\t\"%s\"\n", importPath)
+	}
+	fmt.Fprintf(entityWriter, "\t\"%s\"\n", PQ_IMPORT_PATH)
+	for _, importPath := range append(entityImports(columns, append(imported, PQ_IMPORT_PATH)...), layout.imports(KIND_DAO, KIND_MODEL)...) {
+		fmt.Fprintf(entityWriter, "\t\"%s\"\n", importPath)
 	}
-	defer entityHandle.Close()
-	entityWriter := bufio.NewWriter(entityHandle) // *bufio.Writer
-	fmt.Fprintf(entityWriter, "package main\n\n")
-	fmt.Fprintf(entityWriter, "import (\n\t\"database/sql\"\n")
-	fmt.Fprintf(entityWriter, "\t_ \"github.com/lib/pq\"\n)\n\n")
+	fmt.Fprintf(entityWriter, ")\n\n")
+	writeUserCodeRegion(entityWriter, layout, USER_CODE_IMPORTS)
+	writeTableErrors(entityWriter, layout, table)
 
 	waitForSemilicon := false
-	for _, column := range table.columns {
-		camelName := snakeToCamel(column.Name)
-		goType := postgresToGoType(column.Type)
+	for i, column := range columns {
+		camelName := names.Params[i]
+		goType := columnGoType(column)
 		bufferVars.WriteString(fmt.Sprintf("\tvar %s %s\n", camelName, goType))
 		if waitForSemilicon == true {
 			bufferScan.WriteString(fmt.Sprintf(",&%s", camelName))
@@ -301,27 +710,27 @@ func generateGoEntityDAO(table *Table) error {
 		waitForSemilicon = true
 	}
 
-	fmt.Fprintf(entityWriter, "func rowResultSetTo%s(row *sql.Row) (*%s, error) {\n",entityName, entityName)
+	fmt.Fprintf(entityWriter, "func rowResultSetTo%s(row *sql.Row) (*%s, error) {\n",entityName, entityType)
 	fmt.Fprintf(entityWriter, "\tvar err error\n")
 	fmt.Fprintf(entityWriter, "%s\n",bufferVars.String())
 	fmt.Fprintf(entityWriter, "\terr = row.Scan(%s)\n",bufferScan.String())
 	fmt.Fprintf(entityWriter, "\tif err != nil {\n")
 	fmt.Fprintf(entityWriter, "\t\treturn nil, err\n")
 	fmt.Fprintf(entityWriter, "\t}\n")
-	fmt.Fprintf(entityWriter, "\treturn New%s(%s),nil\n",entityName, bufferNew.String())
+	fmt.Fprintf(entityWriter, "\treturn %s(%s),nil\n",entityConstructor, bufferNew.String())
 	fmt.Fprintf(entityWriter, "}\n\n")
 
-	fmt.Fprintf(entityWriter, "func rowsNoFetchResultSetTo%s(rows *sql.Rows) (*%s, error) {\n",entityName, entityName)
+	fmt.Fprintf(entityWriter, "func rowsNoFetchResultSetTo%s(rows *sql.Rows) (*%s, error) {\n",entityName, entityType)
 	fmt.Fprintf(entityWriter, "\tvar err error\n")
 	fmt.Fprintf(entityWriter, "%s\n",bufferVars.String())
 	fmt.Fprintf(entityWriter, "\terr = rows.Scan(%s)\n",bufferScan.String())
 	fmt.Fprintf(entityWriter, "\tif err != nil {\n")
 	fmt.Fprintf(entityWriter, "\t\treturn nil, err\n")
 	fmt.Fprintf(entityWriter, "\t}\n")
-	fmt.Fprintf(entityWriter, "\treturn New%s(%s),nil\n",entityName, bufferNew.String())
+	fmt.Fprintf(entityWriter, "\treturn %s(%s),nil\n",entityConstructor, bufferNew.String())
 	fmt.Fprintf(entityWriter, "}\n\n")
 
-	fmt.Fprintf(entityWriter, "func rowsResultSetTo%s(rows *sql.Rows) (*%s, error) {\n",entityName, entityName)
+	fmt.Fprintf(entityWriter, "func rowsResultSetTo%s(rows *sql.Rows) (*%s, error) {\n",entityName, entityType)
 	fmt.Fprintf(entityWriter, "\tvar err error\n")
 	fmt.Fprintf(entityWriter, "\tif rows.Next() {\n")
 	fmt.Fprintf(entityWriter, "\t%s\n",bufferVars.String())
@@ -329,41 +738,45 @@ func generateGoEntityDAO(table *Table) error {
 	fmt.Fprintf(entityWriter, "\t\tif err != nil {\n")
 	fmt.Fprintf(entityWriter, "\t\t\treturn nil, err\n")
 	fmt.Fprintf(entityWriter, "\t\t}\n")
-	fmt.Fprintf(entityWriter, "\t\treturn New%s(%s),nil\n",entityName, bufferNew.String())
+	fmt.Fprintf(entityWriter, "\t\treturn %s(%s),nil\n",entityConstructor, bufferNew.String())
 	fmt.Fprintf(entityWriter, "\t}\n")
-	fmt.Fprintf(entityWriter, "\treturn nil, err\n")
+	fmt.Fprintf(entityWriter, "\treturn nil, rows.Err()\n")
 	fmt.Fprintf(entityWriter, "}\n\n")
 
-	camelFirstLowEntityName := strings.ToLower(entityName[:1]) + entityName[1:]
-	fmt.Fprintf(entityWriter, "func load%sById(db *sql.DB, id int64) (*%s, error) {\n", entityName, entityName)
-	fmt.Fprintf(entityWriter, "\trows, err := db.Query(\"select ")
-	waitForSemilicon = false
-	for _, column := range table.columns {
-		if waitForSemilicon == true {
-			fmt.Fprintf(entityWriter, ",%s",column.Name)
-		} else {
-			fmt.Fprintf(entityWriter, "%s",column.Name)
+	camelFirstLowEntityName := names.Local
+	// a table without a single column key has no row to load by id
+	keyParams := primaryKeyParams(table, names)
+	for _, variant := range softDeleteVariants(table) {
+		if len(keyParams) == 0 {
+			break
 		}
-		waitForSemilicon = true
-	}	
-	fmt.Fprintf(entityWriter, " from %s where id=$1\",id)\n", table.Name)
-	fmt.Fprintf(entityWriter, "\tif err != nil {\n")
-	fmt.Fprintf(entityWriter, "\t\treturn nil, err\n")
-	fmt.Fprintf(entityWriter, "\t}\n\n")
-	fmt.Fprintf(entityWriter, "\t%s, err := rowsResultSetTo%s(rows)\n",camelFirstLowEntityName, entityName)
-	fmt.Fprintf(entityWriter, "\tdefer rows.Close()\n")
-	fmt.Fprintf(entityWriter, "\tif err != nil {\n")
-	fmt.Fprintf(entityWriter, "\t\treturn nil, err\n")
-	fmt.Fprintf(entityWriter, "\t}\n")
-	fmt.Fprintf(entityWriter, "\treturn %s, nil\n",camelFirstLowEntityName)
-	fmt.Fprintf(entityWriter, "}\n\n")
+		fmt.Fprintf(entityWriter, "func %s(ctx context.Context, q DBTX, %s) (*%s, error) {\n", layout.funcName("load"+entityName+"ByID"+variant.Suffix), paramDeclarations(keyParams), entityType)
+		query := "select " + sqlColumnList(columns) + " from " + pq.QuoteIdentifier(table.Name) + " where " + keyCondition(keyParams, 1)
+		if variant.Condition != "" {
+			query += " and " + variant.Condition
+		}
+		fmt.Fprintf(entityWriter, "\trows, err := q.QueryContext(ctx, %q,%s)\n", query, paramNames(keyParams, ","))
+		fmt.Fprintf(entityWriter, "\tif err != nil {\n")
+		fmt.Fprintf(entityWriter, "\t\treturn nil, err\n")
+		fmt.Fprintf(entityWriter, "\t}\n\n")
+		fmt.Fprintf(entityWriter, "\t%s, err := rowsResultSetTo%s(rows)\n",camelFirstLowEntityName, entityName)
+		fmt.Fprintf(entityWriter, "\tdefer rows.Close()\n")
+		fmt.Fprintf(entityWriter, "\tif err != nil {\n")
+		fmt.Fprintf(entityWriter, "\t\treturn nil, err\n")
+		fmt.Fprintf(entityWriter, "\t}\n")
+		fmt.Fprintf(entityWriter, "\tif %s == nil {\n",camelFirstLowEntityName)
+		fmt.Fprintf(entityWriter, "\t\treturn nil, %s\n",notFoundErrorName(names))
+		fmt.Fprintf(entityWriter, "\t}\n")
+		fmt.Fprintf(entityWriter, "\treturn %s, nil\n",camelFirstLowEntityName)
+		fmt.Fprintf(entityWriter, "}\n\n")
+	}
 
 	waitForSemilicon = false
-	fmt.Fprintf(entityWriter, "func create%s(db *sql.DB, ",entityName)
-	for _, column := range table.columns {
-		if column.IsPrimary == false {
-			camelName := snakeToCamel(column.Name)
-			goType := postgresToGoType(column.Type)
+	fmt.Fprintf(entityWriter, "func %s(ctx context.Context, q DBTX, ", layout.funcName("create"+entityName))
+	for i, column := range columns {
+		if table.isCreateParam(column) {
+			camelName := names.Params[i]
+			goType := columnGoType(column)
 			if waitForSemilicon == true {
 				fmt.Fprintf(entityWriter, ",%s %s",camelName, goType)
 			} else {
@@ -372,7 +785,7 @@ func generateGoEntityDAO(table *Table) error {
 			waitForSemilicon = true
 		}
 	}	
-	fmt.Fprintf(entityWriter, ") (*%s, error) {\n",entityName)
+	fmt.Fprintf(entityWriter, ") (*%s, error) {\n",entityType)
 
 	var bufferInsertSql bytes.Buffer
 	var bufferInsertValues bytes.Buffer
@@ -382,39 +795,78 @@ func generateGoEntityDAO(table *Table) error {
 	waitForSemiliconWithId := false
 	waitForSemiliconWithoutId := false ;
 	indexValue := 1
-	for _, column := range table.columns {
-		camelName := snakeToCamel(column.Name)
-		if column.IsPrimary == false {
+	for i, column := range columns {
+		camelName := names.Params[i]
+		if table.isCreateParam(column) {
 			if waitForSemiliconWithoutId == false {
 				waitForSemiliconWithoutId = true ;
-				bufferInsertSql.WriteString(fmt.Sprintf("%s",column.Name))
+				bufferInsertSql.WriteString(pq.QuoteIdentifier(column.Name))
 				bufferInsertValues.WriteString(fmt.Sprintf("$%d",indexValue))
-				bufferInsertParameters.WriteString(fmt.Sprintf("%s",camelName))
 			} else {
-				bufferInsertSql.WriteString(fmt.Sprintf(",%s",column.Name))
+				bufferInsertSql.WriteString(","+pq.QuoteIdentifier(column.Name))
 				bufferInsertValues.WriteString(fmt.Sprintf(",$%d",indexValue))
-				bufferInsertParameters.WriteString(fmt.Sprintf(",%s",camelName))
 			}
+			if indexValue > 1 {
+				bufferInsertParameters.WriteString(",")
+			}
+			bufferInsertParameters.WriteString(camelName)
 			indexValue++
+		} else if table.isAuditTimestamp(column) {
+			// the audit timestamps take the time of the transaction
+			if waitForSemiliconWithoutId == false {
+				waitForSemiliconWithoutId = true ;
+				bufferInsertSql.WriteString(pq.QuoteIdentifier(column.Name))
+				bufferInsertValues.WriteString("now()")
+			} else {
+				bufferInsertSql.WriteString(","+pq.QuoteIdentifier(column.Name))
+				bufferInsertValues.WriteString(",now()")
+			}
+		} else if version := table.initialVersion(column); version != "" {
+			// a new row starts at its first version
+			if waitForSemiliconWithoutId == false {
+				waitForSemiliconWithoutId = true ;
+				bufferInsertSql.WriteString(pq.QuoteIdentifier(column.Name))
+				bufferInsertValues.WriteString(version)
+			} else {
+				bufferInsertSql.WriteString(","+pq.QuoteIdentifier(column.Name))
+				bufferInsertValues.WriteString(","+version)
+			}
 		}
 		if waitForSemiliconWithId == false {
 			waitForSemiliconWithId = true ;
-			bufferInsertReturning.WriteString(fmt.Sprintf("%s",column.Name))
+			bufferInsertReturning.WriteString(pq.QuoteIdentifier(column.Name))
 		} else {
-			bufferInsertReturning.WriteString(fmt.Sprintf(",%s",column.Name))
+			bufferInsertReturning.WriteString(","+pq.QuoteIdentifier(column.Name))
 		}
 	}	
-	fmt.Fprintf(entityWriter, "\trows := db.QueryRow(\"insert into %s(%s) values(%s) returning %s\",%s)\n\n",table.Name, bufferInsertSql.String(), bufferInsertValues.String(), bufferInsertReturning.String(), bufferInsertParameters.String())
+	insertQuery := fmt.Sprintf("insert into %s(%s) values(%s) returning %s", pq.QuoteIdentifier(table.Name), bufferInsertSql.String(), bufferInsertValues.String(), bufferInsertReturning.String())
+	fmt.Fprintf(entityWriter, "\trows := q.QueryRowContext(ctx, %q,%s)\n\n", insertQuery, bufferInsertParameters.String())
 	fmt.Fprintf(entityWriter, "\t%s, err := rowResultSetTo%s(rows)\n",camelFirstLowEntityName,entityName)
 	fmt.Fprintf(entityWriter, "\tif err != nil {\n")
-	fmt.Fprintf(entityWriter, "\t\treturn nil, err\n")
+	fmt.Fprintf(entityWriter, "\t\treturn nil, %s(err)\n",mapErrorFuncName(names))
 	fmt.Fprintf(entityWriter, "\t}\n")
 	fmt.Fprintf(entityWriter, "\treturn %s, nil\n",camelFirstLowEntityName)
-	fmt.Fprintf(entityWriter, "}\n")
-	
+	fmt.Fprintf(entityWriter, "}\n\n")
+	writeSoftDelete(entityWriter, layout, table, names)
+	writeUpdate(entityWriter, layout, table, names, columns)
+	writeBulkInserts(entityWriter, layout, table, names, columns)
+	writeIterators(entityWriter, layout, table, names, columns)
+	writeUserCodeRegion(entityWriter, layout, USER_CODE_CODE)
+
 	entityWriter.Flush()
 
-	return nil
+	return sink.writeFile(entityFileName, entityBuffer.Bytes())
+}
+
+// jsonColumns returns the columns mapped in the json struct
+func jsonColumns(columns []*Column) []*Column {
+	result := make([]*Column, 0, len(columns))
+	for _, column := range columns {
+		if !column.OmitFromJson {
+			result = append(result, column)
+		}
+	}
+	return result
 }
 
 func readFile(configFilename string) (string, error) {
@@ -425,10 +877,19 @@ func readFile(configFilename string) (string, error) {
 	return string(contentOfFile), nil
 }
 
-func snakeToCamel(s string) string {
-	words := strings.Split(s, "_")
-	for i := range words {
-		words[i] = strings.Title(words[i])
+// UserAccountDAO --> user_account_dao
+func camelToSnake(s string) string {
+	runes := []rune(s)
+	var buffer bytes.Buffer
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				buffer.WriteRune('_')
+			}
+			buffer.WriteRune(unicode.ToLower(r))
+		} else {
+			buffer.WriteRune(r)
+		}
 	}
-	return strings.Join(words, "")
+	return buffer.String()
 }
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// go test -update rewrites testdata/golden from the current generators
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+const GOLDEN_OUTPUT_DIRECTORY = "out"
+const GOLDEN_IMPORT_PATH = "example.com/app/generated"
+const FIXTURE_OVERRIDES_FILE_NAME = "overrides.json"
+
+var goldenLayouts = map[string]OutputConfig{
+	LAYOUT_FLAT:  {Directory: GOLDEN_OUTPUT_DIRECTORY, Layout: LAYOUT_FLAT},
+	LAYOUT_SPLIT: {Directory: GOLDEN_OUTPUT_DIRECTORY, Layout: LAYOUT_SPLIT, ImportPath: GOLDEN_IMPORT_PATH},
+}
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	console = NewRedactWriter(ioutil.Discard)
+	os.Exit(m.Run())
+}
+
+func fixtureNames(t *testing.T) []string {
+	directories, err := ioutil.ReadDir(filepath.Join("testdata", "fixtures"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := make([]string, 0, 0)
+	for _, directory := range directories {
+		if directory.IsDir() {
+			result = append(result, directory.Name())
+		}
+	}
+	return result
+}
+
+// loadFixture reads the migrations of testdata/fixtures/<name> through the in-memory introspector,
+// and applies the tables overrides of its overrides.json if any
+func loadFixture(t *testing.T, name string) *Schema {
+	logWriter := bufio.NewWriter(ioutil.Discard)
+	introspector, err := NewDDLIntrospector(logWriter, filepath.Join("testdata", "fixtures", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := readSchema(logWriter, introspector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := ioutil.ReadFile(filepath.Join("testdata", "fixtures", name, FIXTURE_OVERRIDES_FILE_NAME))
+	if os.IsNotExist(err) {
+		return schema
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	overrides := make(map[string]TableOverride)
+	err = json.Unmarshal(content, &overrides)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = applyTableOverrides(schema, overrides)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schema
+}
+
+// generateFixture runs every generator and returns the files relative to the output directory
+func generateFixture(t *testing.T, schema *Schema, config OutputConfig) map[string]string {
+	layout, err := NewOutputLayout(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink := NewMemorySink()
+	failures := generateTables(layout, sink, schema.Tables)
+	if failures > 0 {
+		t.Fatalf("%d generator(s) failed", failures)
+	}
+	result := make(map[string]string)
+	for _, fileName := range sink.fileNames {
+		relative, err := filepath.Rel(config.Directory, fileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result[filepath.ToSlash(relative)] = string(sink.files[fileName])
+	}
+	return result
+}
+
+func readGoldenDirectory(t *testing.T, directory string) map[string]string {
+	result := make(map[string]string)
+	err := filepath.Walk(directory, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		relative, err := filepath.Rel(directory, path)
+		if err != nil {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		result[filepath.ToSlash(relative)] = string(content)
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func writeGoldenDirectory(t *testing.T, directory string, files map[string]string) {
+	err := os.RemoveAll(directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for relative, content := range files {
+		fileName := filepath.Join(directory, filepath.FromSlash(relative))
+		err = os.MkdirAll(filepath.Dir(fileName), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(fileName, []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func sortedKeys(files map[string]string) []string {
+	result := make([]string, 0, len(files))
+	for key := range files {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func TestGeneratorsGolden(t *testing.T) {
+	for _, fixture := range fixtureNames(t) {
+		schema := loadFixture(t, fixture)
+		for layoutName, config := range goldenLayouts {
+			t.Run(fixture+"/"+layoutName, func(t *testing.T) {
+				files := generateFixture(t, schema, config)
+				if errors := typeCheckGenerated(files, config); len(errors) > 0 {
+					t.Errorf("generated code does not type check :\n%s", strings.Join(errors, "\n"))
+				}
+				if errors := checkGeneratedSQL(files); len(errors) > 0 {
+					t.Errorf("generated sql holds unquoted names :\n%s", strings.Join(errors, "\n"))
+				}
+
+				goldenDirectory := filepath.Join("testdata", "golden", fixture, layoutName)
+				if *update {
+					writeGoldenDirectory(t, goldenDirectory, files)
+					return
+				}
+				golden := readGoldenDirectory(t, goldenDirectory)
+				for _, relative := range sortedKeys(files) {
+					expected, exists := golden[relative]
+					if !exists {
+						t.Errorf("%s is not in %s, run go test -update", relative, goldenDirectory)
+						continue
+					}
+					if expected != files[relative] {
+						t.Errorf("%s differs from %s\n%s", relative, goldenDirectory, unifiedDiff("golden/"+relative, "generated/"+relative, expected, files[relative]))
+					}
+				}
+				for _, relative := range sortedKeys(golden) {
+					if _, exists := files[relative]; !exists {
+						t.Errorf("%s is no longer generated, run go test -update", filepath.Join(goldenDirectory, relative))
+					}
+				}
+			})
+		}
+	}
+}
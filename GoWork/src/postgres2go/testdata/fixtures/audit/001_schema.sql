@@ -0,0 +1,16 @@
+-- audit timestamps, set to now() by the inserts and the updates
+CREATE TABLE invoice (
+    id bigserial PRIMARY KEY,
+    number text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz,
+    deleted_at timestamptz
+);
+
+CREATE TABLE payment (
+    id bigserial PRIMARY KEY,
+    invoice_id bigint NOT NULL REFERENCES invoice(id),
+    amount numeric(10, 2) NOT NULL,
+    inserted_at timestamp NOT NULL,
+    modified_at timestamp NOT NULL
+);
@@ -0,0 +1,14 @@
+-- the column types the generators map today
+CREATE TABLE org (
+    id bigserial PRIMARY KEY,
+    name text NOT NULL,
+    seats integer NOT NULL,
+    rating double precision NOT NULL
+);
+
+CREATE TABLE user_account (
+    id bigserial PRIMARY KEY,
+    org_id bigint NOT NULL REFERENCES org(id) ON DELETE CASCADE,
+    email text NOT NULL,
+    login_count integer NOT NULL
+);
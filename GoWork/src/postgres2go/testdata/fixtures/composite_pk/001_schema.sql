@@ -0,0 +1,11 @@
+CREATE TABLE project (
+    id bigserial PRIMARY KEY,
+    name text NOT NULL
+);
+
+CREATE TABLE membership (
+    project_id bigint NOT NULL REFERENCES project(id),
+    member_id bigint NOT NULL,
+    role text NOT NULL,
+    PRIMARY KEY (project_id, member_id)
+);
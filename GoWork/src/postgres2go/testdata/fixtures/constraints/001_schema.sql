@@ -0,0 +1,17 @@
+-- the constraints the fake repositories enforce
+CREATE TABLE team (
+    id bigserial PRIMARY KEY,
+    slug text NOT NULL UNIQUE
+);
+
+CREATE TABLE player (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    team_id bigint REFERENCES team(id),
+    email text NOT NULL,
+    nickname text,
+    avatar bytea NOT NULL,
+    UNIQUE (team_id, email)
+);
+
+CREATE UNIQUE INDEX player_nickname_idx ON player (nickname);
+CREATE UNIQUE INDEX player_lower_email_idx ON player (lower(email));
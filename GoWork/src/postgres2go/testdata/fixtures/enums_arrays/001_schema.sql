@@ -0,0 +1,8 @@
+CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy');
+
+CREATE TABLE diary (
+    id bigserial PRIMARY KEY,
+    current_mood mood NOT NULL,
+    tags text[] NOT NULL,
+    scores integer[]
+);
@@ -0,0 +1,32 @@
+CREATE TABLE api_client (
+    id bigserial PRIMARY KEY,
+    api_url text NOT NULL,
+    user_id bigint NOT NULL,
+    "userId" bigint NOT NULL,
+    "2fa_secret" text NOT NULL,
+    "display name" text NOT NULL,
+    "http-status" integer NOT NULL,
+    string text NOT NULL,
+    "err" text NOT NULL,
+    "名前" text NOT NULL,
+    émoji_ünicode text NOT NULL
+);
+
+CREATE TABLE "ApiClient" (
+    id bigserial PRIMARY KEY,
+    rows bigint NOT NULL
+);
+
+-- token.type_id and token_type.id are both TokenTypeID, token_type.query is
+-- the name of the TokenTypeQuery() builder, token.type_id_column is the name
+-- of the type of the TokenTypeID constant
+CREATE TABLE token (
+    id bigserial PRIMARY KEY,
+    type_id bigint NOT NULL,
+    type_id_column bigint NOT NULL
+);
+
+CREATE TABLE token_type (
+    id bigserial PRIMARY KEY,
+    query text NOT NULL
+);
@@ -0,0 +1,7 @@
+CREATE TABLE profile (
+    id bigserial PRIMARY KEY,
+    nickname text,
+    age integer,
+    balance double precision,
+    referrer_id bigint
+);
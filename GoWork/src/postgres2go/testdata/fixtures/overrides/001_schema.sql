@@ -0,0 +1,10 @@
+CREATE TABLE accounts (
+    id bigserial PRIMARY KEY,
+    email text NOT NULL,
+    password_hash text NOT NULL,
+    internal_note text,
+    nickname text,
+    settings text NOT NULL,
+    tags text[] NOT NULL,
+    created_at bigint NOT NULL DEFAULT 0
+);
@@ -0,0 +1,30 @@
+CREATE TABLE users (
+    id bigserial PRIMARY KEY,
+    email text NOT NULL
+);
+
+CREATE TABLE categories (
+    id bigserial PRIMARY KEY,
+    label text NOT NULL
+);
+
+CREATE TABLE user_addresses (
+    id bigserial PRIMARY KEY,
+    user_id bigint NOT NULL REFERENCES users (id),
+    city text NOT NULL
+);
+
+CREATE TABLE people (
+    id bigserial PRIMARY KEY,
+    name text NOT NULL
+);
+
+CREATE TABLE statuses (
+    id bigserial PRIMARY KEY,
+    label text NOT NULL
+);
+
+CREATE TABLE metadata (
+    id bigserial PRIMARY KEY,
+    content text NOT NULL
+);
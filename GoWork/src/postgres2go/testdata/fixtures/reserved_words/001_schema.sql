@@ -0,0 +1,6 @@
+CREATE TABLE "select" (
+    id bigserial PRIMARY KEY,
+    type text NOT NULL,
+    func text NOT NULL,
+    range integer NOT NULL
+);
@@ -0,0 +1,20 @@
+-- soft delete, the column is found by name or configured
+CREATE TABLE article (
+    id bigserial PRIMARY KEY,
+    title text NOT NULL,
+    archived_at timestamptz
+);
+
+CREATE TABLE comment (
+    id bigserial PRIMARY KEY,
+    article_id bigint NOT NULL REFERENCES article(id),
+    body text NOT NULL,
+    deleted_at timestamp
+);
+
+-- keyed on a uuid label_id, the soft delete takes it as a string
+CREATE TABLE label (
+    label_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    name text NOT NULL,
+    deleted_at timestamptz
+);
@@ -0,0 +1,13 @@
+CREATE TABLE event (
+    id bigserial PRIMARY KEY,
+    code varchar(10) NOT NULL,
+    amount numeric(10, 2),
+    priority smallint,
+    ratio real,
+    active boolean NOT NULL DEFAULT true,
+    payload jsonb,
+    raw bytea,
+    happened_on date NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    deleted_at timestamp
+);
@@ -0,0 +1,18 @@
+-- optimistic locking, the version column is found by name or configured
+CREATE TABLE document (
+    id bigserial PRIMARY KEY,
+    title text NOT NULL,
+    version integer NOT NULL DEFAULT 1
+);
+
+CREATE TABLE note (
+    id bigserial PRIMARY KEY,
+    body text,
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE setting (
+    id bigserial PRIMARY KEY,
+    value text NOT NULL,
+    revision bigint NOT NULL DEFAULT 0
+);